@@ -6,12 +6,14 @@ package ec2
 import (
 	"context"
 	"fmt"
+	"log"
 	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -46,6 +48,22 @@ func DataSourceEBSSnapshot() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"fast_snapshot_restores": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"filter": CustomFiltersSchema(),
 			"kms_key_id": {
 				Type:     schema.TypeString,
@@ -183,5 +201,41 @@ func dataSourceEBSSnapshotRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
+	fastSnapshotRestores, err := FindFastSnapshotRestores(ctx, conn, &ec2.DescribeFastSnapshotRestoresInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"snapshot-id": d.Id(),
+		}),
+	})
+
+	switch {
+	// Fast snapshot restores are unknown to callers without ec2:DescribeFastSnapshotRestores permission.
+	case tfawserr.ErrCodeEquals(err, errCodeUnauthorizedOperation, errCodeAccessDenied):
+		log.Printf("[WARN] reading EBS Snapshot (%s) Fast Snapshot Restores: %s", d.Id(), err)
+		d.Set("fast_snapshot_restores", nil)
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading EBS Snapshot (%s) Fast Snapshot Restores: %s", d.Id(), err)
+	default:
+		if err := d.Set("fast_snapshot_restores", flattenFastSnapshotRestores(fastSnapshotRestores)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting fast_snapshot_restores: %s", err)
+		}
+	}
+
 	return diags
 }
+
+func flattenFastSnapshotRestores(apiObjects []*ec2.DescribeFastSnapshotRestoreSuccessItem) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || aws.StringValue(apiObject.State) == ec2.FastSnapshotRestoreStateCodeDisabled {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"availability_zone": aws.StringValue(apiObject.AvailabilityZone),
+			"state":             aws.StringValue(apiObject.State),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// @SDKDataSource("aws_ec2_capacity_reservations")
+func DataSourceCapacityReservations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCapacityReservationsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"capacity_reservations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"available_instance_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"capacity_reservation_fleet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_platform": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_instance_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter": CustomFiltersSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(ec2.CapacityReservationState_Values(), false),
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceCapacityReservationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.DescribeCapacityReservationsInput{}
+
+	input.Filters = append(input.Filters, BuildAttributeFilterList(
+		map[string]string{
+			"instance-type": d.Get("instance_type").(string),
+			"owner-id":      d.Get("owner_id").(string),
+			"state":         d.Get("state").(string),
+		},
+	)...)
+
+	if tags, ok := d.GetOk("tags"); ok {
+		input.Filters = append(input.Filters, BuildTagFilterList(
+			Tags(tftags.New(ctx, tags.(map[string]interface{}))),
+		)...)
+	}
+
+	input.Filters = append(input.Filters, BuildCustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	output, err := FindCapacityReservations(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Capacity Reservations: %s", err)
+	}
+
+	var capacityReservationIDs []string
+	var capacityReservations []interface{}
+
+	for _, v := range output {
+		capacityReservationIDs = append(capacityReservationIDs, aws.StringValue(v.CapacityReservationId))
+		capacityReservations = append(capacityReservations, map[string]interface{}{
+			"arn":                           aws.StringValue(v.CapacityReservationArn),
+			"availability_zone":             aws.StringValue(v.AvailabilityZone),
+			"available_instance_count":      aws.Int64Value(v.AvailableInstanceCount),
+			"capacity_reservation_fleet_id": aws.StringValue(v.CapacityReservationFleetId),
+			"id":                            aws.StringValue(v.CapacityReservationId),
+			"instance_platform":             aws.StringValue(v.InstancePlatform),
+			"instance_type":                 aws.StringValue(v.InstanceType),
+			"owner_id":                      aws.StringValue(v.OwnerId),
+			"state":                         aws.StringValue(v.State),
+			"total_instance_count":          aws.Int64Value(v.TotalInstanceCount),
+		})
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("capacity_reservations", capacityReservations); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting capacity_reservations: %s", err)
+	}
+	d.Set("ids", capacityReservationIDs)
+
+	return diags
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2CapacityReservationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ec2_capacity_reservation.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckCapacityReservation(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapacityReservationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_ec2_capacity_reservations.by_tags", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.aws_ec2_capacity_reservations.by_instance_type", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_ec2_capacity_reservations.by_instance_type", "capacity_reservations.#", "1"),
+					resource.TestCheckResourceAttrPair("data.aws_ec2_capacity_reservations.by_instance_type", "capacity_reservations.0.id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair("data.aws_ec2_capacity_reservations.by_instance_type", "capacity_reservations.0.arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr("data.aws_ec2_capacity_reservations.by_instance_type", "capacity_reservations.0.instance_type", "t2.micro"),
+					resource.TestCheckResourceAttr("data.aws_ec2_capacity_reservations.by_instance_type", "capacity_reservations.0.state", "active"),
+					resource.TestCheckResourceAttr("data.aws_ec2_capacity_reservations.by_instance_type", "capacity_reservations.0.total_instance_count", "1"),
+					resource.TestCheckResourceAttr("data.aws_ec2_capacity_reservations.by_filter", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.aws_ec2_capacity_reservations.empty", "ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCapacityReservationsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ec2_capacity_reservation" "test1" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_count    = 1
+  instance_platform = "Linux/UNIX"
+  instance_type     = "t2.micro"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_capacity_reservation" "test2" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  instance_count    = 1
+  instance_platform = "Linux/UNIX"
+  instance_type     = "t3.micro"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_capacity_reservations" "by_tags" {
+  state = "active"
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_ec2_capacity_reservation.test1, aws_ec2_capacity_reservation.test2]
+}
+
+data "aws_ec2_capacity_reservations" "by_instance_type" {
+  instance_type = "t2.micro"
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_ec2_capacity_reservation.test1, aws_ec2_capacity_reservation.test2]
+}
+
+data "aws_ec2_capacity_reservations" "by_filter" {
+  filter {
+    name   = "instance-type"
+    values = ["t3.micro"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_ec2_capacity_reservation.test1, aws_ec2_capacity_reservation.test2]
+}
+
+data "aws_ec2_capacity_reservations" "empty" {
+  filter {
+    name   = "instance-type"
+    values = ["m5.24xlarge"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_ec2_capacity_reservation.test1, aws_ec2_capacity_reservation.test2]
+}
+`, rName))
+}
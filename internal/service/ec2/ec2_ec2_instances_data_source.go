@@ -0,0 +1,458 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// @SDKDataSource("aws_ec2_instances")
+func DataSourceEC2Instances() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEC2InstancesRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"filter": CustomFiltersSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_state_names": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(ec2.InstanceStateName_Values(), false),
+				},
+			},
+			"instance_tags": tftags.TagsSchemaComputed(),
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ami": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"block_device_mapping": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"delete_on_termination": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"device_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"volume_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"cpu_options": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"amd_sev_snp": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"core_count": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"threads_per_core": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"ebs_optimized": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"enclave_options": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"host_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"iam_instance_profile": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_lifecycle": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ipv6_addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"key_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"launch_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"maintenance_options": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"auto_recovery": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"metadata_options": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"http_endpoint": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"http_protocol_ipv6": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"http_put_response_hop_limit": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"http_tokens": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"instance_metadata_tags": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"monitoring": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"outpost_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"placement_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_dns": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"private_dns_name_options": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enable_resource_name_dns_aaaa_record": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"enable_resource_name_dns_a_record": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"hostname_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"private_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_dns": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_ip": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"root_device_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"security_groups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"source_dest_check": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tftags.TagsSchemaComputed(),
+						"tenancy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_security_group_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEC2InstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	input := &ec2.DescribeInstancesInput{}
+
+	if v, ok := d.GetOk("instance_state_names"); ok && v.(*schema.Set).Len() > 0 {
+		input.Filters = append(input.Filters, &ec2.Filter{
+			Name:   aws.String("instance-state-name"),
+			Values: flex.ExpandStringSet(v.(*schema.Set)),
+		})
+	} else {
+		input.Filters = append(input.Filters, &ec2.Filter{
+			Name:   aws.String("instance-state-name"),
+			Values: aws.StringSlice([]string{ec2.InstanceStateNameRunning}),
+		})
+	}
+
+	input.Filters = append(input.Filters, BuildTagFilterList(
+		Tags(tftags.New(ctx, d.Get("instance_tags").(map[string]interface{}))),
+	)...)
+
+	input.Filters = append(input.Filters, BuildCustomFilterList(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	output, err := FindInstances(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Instances: %s", err)
+	}
+
+	var instanceIDs []string
+	var instances []interface{}
+
+	for _, v := range output {
+		instanceID := aws.StringValue(v.InstanceId)
+		instanceIDs = append(instanceIDs, instanceID)
+
+		tfMap, err := flattenInstanceDescription(ctx, meta.(*conns.AWSClient), v, ignoreTagsConfig)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s): %s", instanceID, err)
+		}
+
+		instances = append(instances, tfMap)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set("ids", instanceIDs)
+	if err := d.Set("instances", instances); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting instances: %s", err)
+	}
+
+	return diags
+}
+
+// flattenInstanceDescription returns the attributes of an instance that are available
+// directly from DescribeInstances, without any per-instance API calls.
+func flattenInstanceDescription(ctx context.Context, client *conns.AWSClient, instance *ec2.Instance, ignoreTagsConfig *tftags.IgnoreConfig) (map[string]interface{}, error) {
+	instanceID := aws.StringValue(instance.InstanceId)
+
+	tfMap := map[string]interface{}{
+		"ami": aws.StringValue(instance.ImageId),
+		"arn": arn.ARN{
+			Partition: client.Partition,
+			Region:    client.Region,
+			Service:   ec2.ServiceName,
+			AccountID: client.AccountID,
+			Resource:  fmt.Sprintf("instance/%s", instanceID),
+		}.String(),
+		"cpu_options":        flattenCPUOptions(instance.CpuOptions),
+		"ebs_optimized":      aws.BoolValue(instance.EbsOptimized),
+		"enclave_options":    flattenEnclaveOptions(instance.EnclaveOptions),
+		"id":                 instanceID,
+		"instance_lifecycle": aws.StringValue(instance.InstanceLifecycle),
+		"instance_type":      aws.StringValue(instance.InstanceType),
+		"key_name":           aws.StringValue(instance.KeyName),
+		"metadata_options":   flattenInstanceMetadataOptions(instance.MetadataOptions),
+		"outpost_arn":        aws.StringValue(instance.OutpostArn),
+		"private_dns":        aws.StringValue(instance.PrivateDnsName),
+		"private_ip":         aws.StringValue(instance.PrivateIpAddress),
+		"public_dns":         aws.StringValue(instance.PublicDnsName),
+		"public_ip":          aws.StringValue(instance.PublicIpAddress),
+		"root_device_name":   aws.StringValue(instance.RootDeviceName),
+		"source_dest_check":  aws.BoolValue(instance.SourceDestCheck),
+		"subnet_id":          aws.StringValue(instance.SubnetId),
+		"tags":               KeyValueTags(ctx, instance.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map(),
+		"vpc_id":             aws.StringValue(instance.VpcId),
+	}
+
+	if v := instance.LaunchTime; v != nil {
+		tfMap["launch_time"] = aws.TimeValue(v).Format(time.RFC3339)
+	}
+
+	if v := instance.State; v != nil {
+		tfMap["instance_state"] = aws.StringValue(v.Name)
+	}
+
+	if v := instance.Placement; v != nil {
+		tfMap["availability_zone"] = aws.StringValue(v.AvailabilityZone)
+		tfMap["host_id"] = aws.StringValue(v.HostId)
+		tfMap["placement_group"] = aws.StringValue(v.GroupName)
+		tfMap["tenancy"] = aws.StringValue(v.Tenancy)
+	}
+
+	if v := instance.Monitoring; v != nil {
+		monitoringState := aws.StringValue(v.State)
+		tfMap["monitoring"] = monitoringState == ec2.MonitoringStateEnabled || monitoringState == ec2.MonitoringStatePending
+	}
+
+	if v := instance.IamInstanceProfile; v != nil && v.Arn != nil {
+		name, err := InstanceProfileARNToName(aws.StringValue(v.Arn))
+
+		if err != nil {
+			return nil, fmt.Errorf("reading iam_instance_profile: %w", err)
+		}
+
+		tfMap["iam_instance_profile"] = name
+	}
+
+	if v := instance.MaintenanceOptions; v != nil {
+		tfMap["maintenance_options"] = []interface{}{flattenInstanceMaintenanceOptions(v)}
+	}
+
+	if v := instance.PrivateDnsNameOptions; v != nil {
+		tfMap["private_dns_name_options"] = []interface{}{flattenPrivateDNSNameOptionsResponse(v)}
+	}
+
+	var blockDeviceMappings []interface{}
+	for _, v := range instance.BlockDeviceMappings {
+		if v == nil || v.Ebs == nil {
+			continue
+		}
+
+		blockDeviceMappings = append(blockDeviceMappings, map[string]interface{}{
+			"delete_on_termination": aws.BoolValue(v.Ebs.DeleteOnTermination),
+			"device_name":           aws.StringValue(v.DeviceName),
+			"status":                aws.StringValue(v.Ebs.Status),
+			"volume_id":             aws.StringValue(v.Ebs.VolumeId),
+		})
+	}
+	tfMap["block_device_mapping"] = blockDeviceMappings
+
+	var securityGroupIDs, securityGroupNames []string
+	for _, v := range instance.SecurityGroups {
+		securityGroupIDs = append(securityGroupIDs, aws.StringValue(v.GroupId))
+		securityGroupNames = append(securityGroupNames, aws.StringValue(v.GroupName))
+	}
+	tfMap["security_groups"] = securityGroupNames
+	tfMap["vpc_security_group_ids"] = securityGroupIDs
+
+	var ipv6Addresses []string
+	for _, v := range instance.NetworkInterfaces {
+		if v == nil || v.Attachment == nil || aws.Int64Value(v.Attachment.DeviceIndex) != 0 {
+			continue
+		}
+
+		for _, v := range v.Ipv6Addresses {
+			ipv6Addresses = append(ipv6Addresses, aws.StringValue(v.Ipv6Address))
+		}
+	}
+	tfMap["ipv6_addresses"] = ipv6Addresses
+
+	return tfMap, nil
+}
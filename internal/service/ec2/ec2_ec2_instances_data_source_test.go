@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2EC2InstancesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_instances.test"
+	resourceName := "aws_instance.test.0"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEC2InstancesDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "instances.*.id", resourceName, "id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "instances.*.arn", resourceName, "arn"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "instances.*.ami", resourceName, "ami"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "instances.*.instance_type", resourceName, "instance_type"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "instances.*.subnet_id", resourceName, "subnet_id"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "instances.*.private_ip", resourceName, "private_ip"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "instances.*.vpc_security_group_ids.0", resourceName, "vpc_security_group_ids.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.block_device_mapping.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.instance_state", "running"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.metadata_options.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.metadata_options.0.http_tokens", "required"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2EC2InstancesDataSource_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_instances.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEC2InstancesDataSourceConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEC2InstancesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		acctest.ConfigVPCWithSubnets(rName, 1),
+		fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_instance" "test" {
+  count                  = 2
+  ami                    = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type          = data.aws_ec2_instance_type_offering.available.instance_type
+  subnet_id              = aws_subnet.test[0].id
+  vpc_security_group_ids = [aws_security_group.test.id]
+
+  metadata_options {
+    http_tokens = "required"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_instances" "test" {
+  instance_tags = {
+    Name = %[1]q
+  }
+
+  filter {
+    name   = "instance-id"
+    values = aws_instance.test[*].id
+  }
+}
+`, rName))
+}
+
+func testAccEC2InstancesDataSourceConfig_empty(rName string) string {
+	return fmt.Sprintf(`
+data "aws_ec2_instances" "test" {
+  instance_tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
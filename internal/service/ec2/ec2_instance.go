@@ -61,6 +61,11 @@ func ResourceInstance() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"allow_stop": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"ami": {
 				Type:         schema.TypeString,
 				ForceNew:     true,
@@ -905,6 +910,7 @@ func ResourceInstance() *schema.Resource {
 			customdiff.ForceNewIf("user_data_base64", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.Get("user_data_replace_on_change").(bool)
 			}),
+			customizeDiffInstanceChangePlan,
 		),
 	}
 }
@@ -1661,8 +1667,9 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	if d.HasChanges("instance_type", "user_data", "user_data_base64") && !d.IsNewResource() {
-		// For each argument change, we start and stop the instance
+		// If allow_stop is set, for each argument change, we start and stop the instance
 		// to account for behaviors occurring outside terraform.
+		// Otherwise the instance is already stopped (see customizeDiffInstanceChangePlan) and is modified as is.
 		// Only one attribute can be modified at a time, else we get
 		// "InvalidParameterCombination: Fields for multiple attribute types specified"
 		if d.HasChange("instance_type") {
@@ -1675,7 +1682,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				},
 			}
 
-			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input); err != nil {
+			if err := modifyInstanceAttributeWithOptionalStopStart(ctx, conn, input, d.Get("allow_stop").(bool)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) type: %s", d.Id(), err)
 			}
 		}
@@ -1702,7 +1709,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				},
 			}
 
-			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input); err != nil {
+			if err := modifyInstanceAttributeWithOptionalStopStart(ctx, conn, input, d.Get("allow_stop").(bool)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) user data: %s", d.Id(), err)
 			}
 		}
@@ -1725,7 +1732,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				},
 			}
 
-			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input); err != nil {
+			if err := modifyInstanceAttributeWithOptionalStopStart(ctx, conn, input, d.Get("allow_stop").(bool)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) user data base64: %s", d.Id(), err)
 			}
 		}
@@ -1939,7 +1946,8 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	// To modify capacity reservation attributes of an instance, instance state needs to be in ec2.InstanceStateNameStopped,
-	// otherwise the modification will return an IncorrectInstanceState error
+	// otherwise the modification will return an IncorrectInstanceState error.
+	// A running instance is only stopped and started again if allow_stop is true.
 	if d.HasChange("capacity_reservation_specification") && !d.IsNewResource() {
 		if v, ok := d.GetOk("capacity_reservation_specification"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 			if v := expandCapacityReservationSpecification(v.([]interface{})[0].(map[string]interface{})); v != nil && (v.CapacityReservationPreference != nil || v.CapacityReservationTarget != nil) {
@@ -1948,15 +1956,21 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 					InstanceId:                       aws.String(d.Id()),
 				}
 
-				log.Printf("[DEBUG] Modifying EC2 Instance capacity reservation attributes: %s", input)
-				_, err := conn.ModifyInstanceCapacityReservationAttributesWithContext(ctx, input)
+				if d.Get("allow_stop").(bool) {
+					if err := modifyInstanceCapacityReservationWithStopStart(ctx, conn, input); err != nil {
+						return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) capacity reservation attributes: %s", d.Id(), err)
+					}
+				} else {
+					log.Printf("[DEBUG] Modifying EC2 Instance capacity reservation attributes: %s", input)
+					_, err := conn.ModifyInstanceCapacityReservationAttributesWithContext(ctx, input)
 
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) capacity reservation attributes: %s", d.Id(), err)
-				}
+					if err != nil {
+						return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) capacity reservation attributes: %s", d.Id(), err)
+					}
 
-				if _, err := WaitInstanceCapacityReservationSpecificationUpdated(ctx, conn, d.Id(), v); err != nil {
-					return sdkdiag.AppendErrorf(diags, "waiting for EC2 Instance (%s) capacity reservation attributes update: %s", d.Id(), err)
+					if _, err := WaitInstanceCapacityReservationSpecificationUpdated(ctx, conn, d.Id(), v); err != nil {
+						return sdkdiag.AppendErrorf(diags, "waiting for EC2 Instance (%s) capacity reservation attributes update: %s", d.Id(), err)
+					}
 				}
 			}
 		}
@@ -2104,6 +2118,63 @@ func modifyInstanceAttributeWithStopStart(ctx context.Context, conn *ec2.EC2, in
 	return nil
 }
 
+// modifyInstanceAttributeWithOptionalStopStart modifies an attribute of an instance,
+// stopping the instance first and starting it again afterwards if allowStop is true.
+func modifyInstanceAttributeWithOptionalStopStart(ctx context.Context, conn *ec2.EC2, input *ec2.ModifyInstanceAttributeInput, allowStop bool) error {
+	if allowStop {
+		return modifyInstanceAttributeWithStopStart(ctx, conn, input)
+	}
+
+	if _, err := conn.ModifyInstanceAttributeWithContext(ctx, input); err != nil {
+		return fmt.Errorf("modifying EC2 Instance (%s) attribute: %w", aws.StringValue(input.InstanceId), err)
+	}
+
+	return nil
+}
+
+// modifyInstanceCapacityReservationWithStopStart modifies the capacity reservation attributes of an instance,
+// stopping the instance first if it is running and starting it again afterwards.
+func modifyInstanceCapacityReservationWithStopStart(ctx context.Context, conn *ec2.EC2, input *ec2.ModifyInstanceCapacityReservationAttributesInput) error {
+	id := aws.StringValue(input.InstanceId)
+
+	instance, err := FindInstanceByID(ctx, conn, id)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Instance (%s): %w", id, err)
+	}
+
+	running := aws.StringValue(instance.State.Name) != ec2.InstanceStateNameStopped
+
+	if running {
+		if err := StopInstance(ctx, conn, id, InstanceStopTimeout); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Modifying EC2 Instance capacity reservation attributes: %s", input)
+	if _, err := conn.ModifyInstanceCapacityReservationAttributesWithContext(ctx, input); err != nil {
+		return fmt.Errorf("modifying EC2 Instance (%s) capacity reservation attributes: %w", id, err)
+	}
+
+	if _, err := WaitInstanceCapacityReservationSpecificationUpdated(ctx, conn, id, input.CapacityReservationSpecification); err != nil {
+		return fmt.Errorf("waiting for EC2 Instance (%s) capacity reservation attributes update: %w", id, err)
+	}
+
+	if running {
+		if _, err := conn.StartInstancesWithContext(ctx, &ec2.StartInstancesInput{
+			InstanceIds: aws.StringSlice([]string{id}),
+		}); err != nil {
+			return fmt.Errorf("starting EC2 Instance (%s): %w", id, err)
+		}
+
+		if _, err := WaitInstanceStarted(ctx, conn, id, InstanceStartTimeout); err != nil {
+			return fmt.Errorf("waiting for EC2 Instance (%s) start: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
 func readBlockDevices(ctx context.Context, d *schema.ResourceData, instance *ec2.Instance, conn *ec2.EC2) error {
 	ibds, err := readBlockDevicesFromInstance(ctx, d, instance, conn)
 	if err != nil {
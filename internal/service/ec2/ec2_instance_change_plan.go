@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// instanceChangeAction describes how a change to an aws_instance argument is applied.
+type instanceChangeAction int
+
+const (
+	// The change is applied to the running instance without interruption.
+	instanceChangeActionInPlace instanceChangeAction = iota
+	// The instance is stopped, modified and started again.
+	instanceChangeActionStopStart
+	// The instance is destroyed and a new instance is created.
+	instanceChangeActionReplace
+)
+
+func (a instanceChangeAction) String() string {
+	switch a {
+	case instanceChangeActionStopStart:
+		return "stop/start"
+	case instanceChangeActionReplace:
+		return "replace"
+	default:
+		return "in-place"
+	}
+}
+
+// instanceStopStartAttributes are the arguments that can only be modified
+// while the instance is stopped. See modifyInstanceAttributeWithStopStart and
+// modifyInstanceCapacityReservationWithStopStart.
+var instanceStopStartAttributes = []string{
+	"capacity_reservation_specification",
+	"instance_type",
+	"user_data",
+	"user_data_base64",
+}
+
+type instanceChange struct {
+	attribute string
+	action    instanceChangeAction
+}
+
+type instanceChangePlan []instanceChange
+
+// requires reports whether any change in the plan is applied with the specified action.
+func (p instanceChangePlan) requires(action instanceChangeAction) bool {
+	for _, c := range p {
+		if c.action == action {
+			return true
+		}
+	}
+
+	return false
+}
+
+// attributes returns the names of the attributes changed with the specified action.
+func (p instanceChangePlan) attributes(action instanceChangeAction) []string {
+	var attributes []string
+
+	for _, c := range p {
+		if c.action == action {
+			attributes = append(attributes, c.attribute)
+		}
+	}
+
+	return attributes
+}
+
+func (p instanceChangePlan) String() string {
+	changes := make([]string, 0, len(p))
+
+	for _, c := range p {
+		changes = append(changes, fmt.Sprintf("%s (%s)", c.attribute, c.action))
+	}
+
+	return strings.Join(changes, ", ")
+}
+
+type instanceChangeDiffer interface {
+	Get(key string) any
+	HasChange(key string) bool
+}
+
+// planInstanceChanges classifies each changed, configurable argument in diff as an
+// in-place, stop/start or replacement change.
+// Replacement is derived from the schema's ForceNew markers (including ForceNew
+// arguments nested in blocks) and user_data_replace_on_change.
+// Changes to a launch template version that forces replacement are decided by the
+// instance's current template version and are not reported here.
+func planInstanceChanges(diff instanceChangeDiffer, s map[string]*schema.Schema) instanceChangePlan {
+	var plan instanceChangePlan
+
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := s[k]
+
+		if !v.Optional && !v.Required {
+			continue
+		}
+
+		if !diff.HasChange(k) {
+			continue
+		}
+
+		plan = append(plan, instanceChange{
+			attribute: k,
+			action:    classifyInstanceChange(diff, k, v),
+		})
+	}
+
+	return plan
+}
+
+func classifyInstanceChange(diff instanceChangeDiffer, k string, v *schema.Schema) instanceChangeAction {
+	switch k {
+	case "user_data", "user_data_base64":
+		if diff.Get("user_data_replace_on_change").(bool) {
+			return instanceChangeActionReplace
+		}
+	}
+
+	for _, attribute := range instanceStopStartAttributes {
+		if k == attribute {
+			return instanceChangeActionStopStart
+		}
+	}
+
+	elem, ok := v.Elem.(*schema.Resource)
+
+	if !ok {
+		if v.ForceNew {
+			return instanceChangeActionReplace
+		}
+
+		return instanceChangeActionInPlace
+	}
+
+	// Single nested block: only the block's presence and its ForceNew arguments force replacement.
+	if v.Type == schema.TypeList && v.MaxItems == 1 {
+		if v.ForceNew && diff.HasChange(k+".#") {
+			return instanceChangeActionReplace
+		}
+
+		for nk, nv := range elem.Schema {
+			if nv.ForceNew && diff.HasChange(k+".0."+nk) {
+				return instanceChangeActionReplace
+			}
+		}
+
+		return instanceChangeActionInPlace
+	}
+
+	// Any change to a block set or list replaces its elements.
+	if v.ForceNew {
+		return instanceChangeActionReplace
+	}
+
+	for _, nv := range elem.Schema {
+		if nv.ForceNew {
+			return instanceChangeActionReplace
+		}
+	}
+
+	return instanceChangeActionInPlace
+}
+
+var (
+	instanceChangePlanSchemaOnce sync.Once
+	instanceChangePlanSchema     map[string]*schema.Schema
+)
+
+// instanceSchema returns the aws_instance schema, which is built once and reused for every diff.
+func instanceSchema() map[string]*schema.Schema {
+	instanceChangePlanSchemaOnce.Do(func() {
+		instanceChangePlanSchema = ResourceInstance().Schema
+	})
+
+	return instanceChangePlanSchema
+}
+
+// customizeDiffInstanceChangePlan rejects in-place updates that require stopping a running instance
+// unless allow_stop is true.
+func customizeDiffInstanceChangePlan(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	plan := planInstanceChanges(diff, instanceSchema())
+
+	if len(plan) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] EC2 Instance (%s) planned changes: %s", diff.Id(), plan)
+
+	if plan.requires(instanceChangeActionStopStart) && !plan.requires(instanceChangeActionReplace) && !diff.Get("allow_stop").(bool) && diff.Get("instance_state").(string) != ec2.InstanceStateNameStopped {
+		return fmt.Errorf("changing %s requires stopping EC2 Instance (%s): set allow_stop to true or stop the instance", strings.Join(plan.attributes(instanceChangeActionStopStart), ", "), diff.Id())
+	}
+
+	return nil
+}
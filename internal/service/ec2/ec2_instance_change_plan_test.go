@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type mockInstanceChangeDiffer struct {
+	changes map[string]bool
+	values  map[string]any
+}
+
+func (d *mockInstanceChangeDiffer) Get(key string) any {
+	return d.values[key]
+}
+
+func (d *mockInstanceChangeDiffer) HasChange(key string) bool {
+	return d.changes[key]
+}
+
+func TestPlanInstanceChanges(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		changes                 []string
+		userDataReplaceOnChange bool
+		expected                string
+		expectStop              bool
+		expectReplace           bool
+	}{
+		"no changes": {
+			expected: "",
+		},
+		"tags": {
+			changes:  []string{"tags"},
+			expected: "tags (in-place)",
+		},
+		"instance type": {
+			changes:    []string{"instance_type"},
+			expected:   "instance_type (stop/start)",
+			expectStop: true,
+		},
+		"user data": {
+			changes:    []string{"user_data"},
+			expected:   "user_data (stop/start)",
+			expectStop: true,
+		},
+		"user data replace on change": {
+			changes:                 []string{"user_data"},
+			userDataReplaceOnChange: true,
+			expected:                "user_data (replace)",
+			expectReplace:           true,
+		},
+		"capacity reservation": {
+			changes:    []string{"capacity_reservation_specification", "capacity_reservation_specification.0.capacity_reservation_preference"},
+			expected:   "capacity_reservation_specification (stop/start)",
+			expectStop: true,
+		},
+		"root volume": {
+			changes:  []string{"root_block_device", "root_block_device.0.iops", "root_block_device.0.throughput", "root_block_device.0.volume_type"},
+			expected: "root_block_device (in-place)",
+		},
+		"root volume encryption": {
+			changes:       []string{"root_block_device", "root_block_device.0.encrypted"},
+			expected:      "root_block_device (replace)",
+			expectReplace: true,
+		},
+		"launch template version": {
+			changes:  []string{"launch_template", "launch_template.0.version"},
+			expected: "launch_template (in-place)",
+		},
+		"launch template": {
+			changes:       []string{"launch_template", "launch_template.#"},
+			expected:      "launch_template (replace)",
+			expectReplace: true,
+		},
+		"ebs block device": {
+			changes:       []string{"ebs_block_device"},
+			expected:      "ebs_block_device (replace)",
+			expectReplace: true,
+		},
+		"mixed": {
+			changes:       []string{"ami", "instance_type", "monitoring"},
+			expected:      "ami (replace), instance_type (stop/start), monitoring (in-place)",
+			expectStop:    true,
+			expectReplace: true,
+		},
+		"computed only": {
+			changes:  []string{"arn", "instance_state"},
+			expected: "",
+		},
+	}
+
+	s := instanceSchema()
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diff := &mockInstanceChangeDiffer{
+				changes: make(map[string]bool),
+				values: map[string]any{
+					"user_data_replace_on_change": testCase.userDataReplaceOnChange,
+				},
+			}
+			for _, v := range testCase.changes {
+				diff.changes[v] = true
+			}
+
+			plan := planInstanceChanges(diff, s)
+
+			if got, want := plan.String(), testCase.expected; !cmp.Equal(got, want) {
+				t.Errorf("unexpected plan: %s", cmp.Diff(got, want))
+			}
+
+			if got, want := plan.requires(instanceChangeActionStopStart), testCase.expectStop; got != want {
+				t.Errorf("requires stop/start = %t, want %t", got, want)
+			}
+
+			if got, want := plan.requires(instanceChangeActionReplace), testCase.expectReplace; got != want {
+				t.Errorf("requires replace = %t, want %t", got, want)
+			}
+		})
+	}
+}
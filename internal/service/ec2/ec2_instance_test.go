@@ -540,7 +540,7 @@ func TestAccEC2Instance_userDataBase64(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"user_data", "user_data_replace_on_change"},
+				ImportStateVerifyIgnore: []string{"allow_stop", "user_data", "user_data_replace_on_change"},
 			},
 		},
 	})
@@ -575,7 +575,7 @@ func TestAccEC2Instance_userDataBase64_updateWithBashFile(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"user_data", "user_data_replace_on_change"},
+				ImportStateVerifyIgnore: []string{"allow_stop", "user_data", "user_data_replace_on_change"},
 			},
 		},
 	})
@@ -610,7 +610,7 @@ func TestAccEC2Instance_userDataBase64_updateWithZipFile(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"user_data", "user_data_replace_on_change"},
+				ImportStateVerifyIgnore: []string{"allow_stop", "user_data", "user_data_replace_on_change"},
 			},
 		},
 	})
@@ -646,7 +646,7 @@ func TestAccEC2Instance_userDataBase64_update(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"user_data", "user_data_replace_on_change"},
+				ImportStateVerifyIgnore: []string{"allow_stop", "user_data", "user_data_replace_on_change"},
 			},
 		},
 	})
@@ -2078,6 +2078,50 @@ func TestAccEC2Instance_changeInstanceType(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_allowStop(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before ec2.Instance
+	var after ec2.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_allowStop(rName, "t2.medium", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "allow_stop", "false"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.medium"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_stop", "user_data_replace_on_change"},
+			},
+			{
+				Config:      testAccInstanceConfig_allowStop(rName, "t2.large", false),
+				ExpectError: regexache.MustCompile(`changing instance_type requires stopping EC2 Instance .*: set allow_stop to true`),
+			},
+			{
+				Config: testAccInstanceConfig_allowStop(rName, "t2.large", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "allow_stop", "true"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.large"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_changeInstanceTypeAndUserData(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v ec2.Instance
@@ -2115,7 +2159,7 @@ func TestAccEC2Instance_changeInstanceTypeAndUserData(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"user_data", "user_data_replace_on_change"},
+				ImportStateVerifyIgnore: []string{"allow_stop", "user_data", "user_data_replace_on_change"},
 			},
 		},
 	})
@@ -2153,7 +2197,7 @@ func TestAccEC2Instance_changeInstanceTypeAndUserDataBase64(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"user_data", "user_data_replace_on_change"},
+				ImportStateVerifyIgnore: []string{"allow_stop", "user_data", "user_data_replace_on_change"},
 			},
 		},
 	})
@@ -4691,7 +4735,7 @@ func TestAccEC2Instance_UserData(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"user_data", "user_data_replace_on_change"},
+				ImportStateVerifyIgnore: []string{"allow_stop", "user_data", "user_data_replace_on_change"},
 			},
 		},
 	})
@@ -4725,7 +4769,7 @@ func TestAccEC2Instance_UserData_update(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"user_data", "user_data_replace_on_change"},
+				ImportStateVerifyIgnore: []string{"allow_stop", "user_data", "user_data_replace_on_change"},
 			},
 		},
 	})
@@ -4759,7 +4803,7 @@ func TestAccEC2Instance_UserData_stringToEncodedString(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"user_data", "user_data_replace_on_change"},
+				ImportStateVerifyIgnore: []string{"allow_stop", "user_data", "user_data_replace_on_change"},
 			},
 		},
 	})
@@ -4855,7 +4899,7 @@ func TestAccEC2Instance_UserDataReplaceOnChange_On(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"user_data_replace_on_change"},
+				ImportStateVerifyIgnore: []string{"allow_stop", "user_data_replace_on_change"},
 			},
 			// Switching should force a recreate
 			{
@@ -4891,7 +4935,7 @@ func TestAccEC2Instance_UserDataReplaceOnChange_On_Base64(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"user_data_replace_on_change", "user_data"},
+				ImportStateVerifyIgnore: []string{"allow_stop", "user_data_replace_on_change", "user_data"},
 			},
 			// Switching should force a recreate
 			{
@@ -4927,7 +4971,7 @@ func TestAccEC2Instance_UserDataReplaceOnChange_Off(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"user_data_replace_on_change"},
+				ImportStateVerifyIgnore: []string{"allow_stop", "user_data_replace_on_change"},
 			},
 			// Switching should not force a recreate
 			{
@@ -4963,7 +5007,7 @@ func TestAccEC2Instance_UserDataReplaceOnChange_Off_Base64(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"user_data_replace_on_change", "user_data"},
+				ImportStateVerifyIgnore: []string{"allow_stop", "user_data_replace_on_change", "user_data"},
 			},
 			// Switching should not force a recreate
 			{
@@ -5945,6 +5989,8 @@ resource "aws_instance" "test" {
   ami       = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
   subnet_id = aws_subnet.test.id
 
+  allow_stop = true
+
   instance_type = "t2.small"
   user_data     = %[2]q
 
@@ -5964,6 +6010,8 @@ resource "aws_instance" "test" {
   ami       = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
   subnet_id = aws_subnet.test.id
 
+  allow_stop = true
+
   instance_type = "t2.small"
   user_data     = base64encode(%[2]q)
 
@@ -5983,6 +6031,8 @@ resource "aws_instance" "test" {
   ami       = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
   subnet_id = aws_subnet.test.id
 
+  allow_stop = true
+
   instance_type    = "t2.small"
   user_data_base64 = base64encode(%[2]q)
 
@@ -6002,6 +6052,8 @@ resource "aws_instance" "test" {
   ami       = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
   subnet_id = aws_subnet.test.id
 
+  allow_stop = true
+
   instance_type    = "t2.small"
   user_data_base64 = filebase64(%[2]q)
 
@@ -6039,6 +6091,8 @@ resource "aws_instance" "test" {
   ami       = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
   subnet_id = aws_subnet.test.id
 
+  allow_stop = true
+
   instance_type = "t2.large"
 
   tags = {
@@ -6048,6 +6102,25 @@ resource "aws_instance" "test" {
 `, rName))
 }
 
+func testAccInstanceConfig_allowStop(rName, instanceType string, allowStop bool) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami       = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  subnet_id = aws_subnet.test.id
+
+  allow_stop    = %[3]t
+  instance_type = %[2]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, instanceType, allowStop))
+}
+
 func testAccInstanceConfig_typeAndUserData(rName, instanceType, userData string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinuxHVMEBSAMI(),
@@ -6057,6 +6130,8 @@ resource "aws_instance" "test" {
   ami       = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
   subnet_id = aws_subnet.test.id
 
+  allow_stop = true
+
   instance_type = %[2]q
   user_data     = %[3]q
 
@@ -6076,6 +6151,8 @@ resource "aws_instance" "test" {
   ami       = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
   subnet_id = aws_subnet.test.id
 
+  allow_stop = true
+
   instance_type    = %[2]q
   user_data_base64 = base64encode(%[3]q)
 
@@ -8457,6 +8534,7 @@ func testAccInstanceConfig_userDataSpecifiedReplaceFlag(rName string, userData s
 		testAccInstanceVPCConfig(rName, false, 0),
 		fmt.Sprintf(`
 resource "aws_instance" "test" {
+  allow_stop                  = true
   ami                         = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
   instance_type               = "t2.micro"
   subnet_id                   = aws_subnet.test.id
@@ -8476,6 +8554,7 @@ func testAccInstanceConfig_userData64SpecifiedReplaceFlag(rName string, userData
 		testAccInstanceVPCConfig(rName, false, 0),
 		fmt.Sprintf(`
 resource "aws_instance" "test" {
+  allow_stop                  = true
   ami                         = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
   instance_type               = "t2.micro"
   subnet_id                   = aws_subnet.test.id
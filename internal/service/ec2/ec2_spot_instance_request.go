@@ -64,6 +64,9 @@ func ResourceSpotInstanceRequest() *schema.Resource {
 			delete(s, "instance_market_options")
 			delete(s, "spot_instance_request_id")
 
+			// Remove attributes that only apply to updates of on-demand instances.
+			delete(s, "allow_stop")
+
 			s["block_duration_minutes"] = &schema.Schema{
 				Type:         schema.TypeInt,
 				Optional:     true,
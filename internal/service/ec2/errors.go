@@ -13,6 +13,7 @@ import (
 )
 
 const (
+	errCodeAccessDenied                                      = "AccessDenied"
 	errCodeAnalysisExistsForNetworkInsightsPath              = "AnalysisExistsForNetworkInsightsPath"
 	errCodeAuthFailure                                       = "AuthFailure"
 	errCodeClientInvalidHostIDNotFound                       = "Client.InvalidHostID.NotFound"
@@ -29,6 +30,7 @@ const (
 	errCodeInvalidAssociationIDNotFound                      = "InvalidAssociationID.NotFound"
 	errCodeInvalidAttachmentIDNotFound                       = "InvalidAttachmentID.NotFound"
 	errCodeInvalidCapacityReservationIdNotFound              = "InvalidCapacityReservationId.NotFound'"
+	errCodeInvalidCapacityReservationFleetIdNotFound         = "InvalidCapacityReservationFleetId.NotFound"
 	errCodeInvalidCarrierGatewayIDNotFound                   = "InvalidCarrierGatewayID.NotFound"
 	errCodeInvalidClientVPNActiveAssociationNotFound         = "InvalidClientVpnActiveAssociationNotFound"
 	errCodeInvalidClientVPNAssociationIdNotFound             = "InvalidClientVpnAssociationIdNotFound"
@@ -70,6 +72,7 @@ const (
 	errCodeInvalidParameterException                         = "InvalidParameterException"
 	errCodeInvalidParameterValue                             = "InvalidParameterValue"
 	errCodeInvalidPermissionDuplicate                        = "InvalidPermission.Duplicate"
+	errCodeInvalidPermissionIDNotFound                       = "InvalidPermissionID.NotFound"
 	errCodeInvalidPermissionNotFound                         = "InvalidPermission.NotFound"
 	errCodeInvalidPlacementGroupUnknown                      = "InvalidPlacementGroup.Unknown"
 	errCodeInvalidPoolIDNotFound                             = "InvalidPoolID.NotFound"
@@ -100,6 +103,9 @@ const (
 	errCodeInvalidTransitGatewayPolicyTableIdNotFound        = "InvalidTransitGatewayPolicyTableId.NotFound"
 	errCodeInvalidTransitGatewayIDNotFound                   = "InvalidTransitGatewayID.NotFound"
 	errCodeInvalidTransitGatewayMulticastDomainIdNotFound    = "InvalidTransitGatewayMulticastDomainId.NotFound"
+	errCodeInvalidVerifiedAccessEndpointIdNotFound           = "InvalidVerifiedAccessEndpointId.NotFound"
+	errCodeInvalidVerifiedAccessGroupIdNotFound              = "InvalidVerifiedAccessGroupId.NotFound"
+	errCodeInvalidVerifiedAccessInstanceIdNotFound           = "InvalidVerifiedAccessInstanceId.NotFound"
 	errCodeInvalidVerifiedAccessTrustProviderIdNotFound      = "InvalidVerifiedAccessTrustProviderId.NotFound"
 	errCodeInvalidVolumeNotFound                             = "InvalidVolume.NotFound"
 	errCodeInvalidVPCCIDRBlockAssociationIDNotFound          = "InvalidVpcCidrBlockAssociationID.NotFound"
@@ -116,6 +122,7 @@ const (
 	errCodePrefixListVersionMismatch                         = "PrefixListVersionMismatch"
 	errCodeResourceNotReady                                  = "ResourceNotReady"
 	errCodeSnapshotCreationPerVolumeRateExceeded             = "SnapshotCreationPerVolumeRateExceeded"
+	errCodeUnauthorizedOperation                             = "UnauthorizedOperation"
 	errCodeUnsupportedOperation                              = "UnsupportedOperation"
 	errCodeVolumeInUse                                       = "VolumeInUse"
 	errCodeVPNConnectionLimitExceeded                        = "VpnConnectionLimitExceeded"
@@ -162,6 +169,66 @@ func DeleteFleetsError(apiObjects []*ec2.DeleteFleetErrorItem) error {
 	return errors.Join(errs...)
 }
 
+func DisableFastSnapshotRestoreStateError(apiObject *ec2.DisableFastSnapshotRestoreStateError) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.Code), aws.StringValue(apiObject.Message), nil)
+}
+
+func DisableFastSnapshotRestoresError(apiObjects []*ec2.DisableFastSnapshotRestoreErrorItem) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		for _, v := range apiObject.FastSnapshotRestoreStateErrors {
+			if v == nil {
+				continue
+			}
+
+			if err := DisableFastSnapshotRestoreStateError(v.Error); err != nil {
+				errs = append(errs, fmt.Errorf("%s %s: %w", aws.StringValue(apiObject.SnapshotId), aws.StringValue(v.AvailabilityZone), err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func EnableFastSnapshotRestoreStateError(apiObject *ec2.EnableFastSnapshotRestoreStateError) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	return awserr.New(aws.StringValue(apiObject.Code), aws.StringValue(apiObject.Message), nil)
+}
+
+func EnableFastSnapshotRestoresError(apiObjects []*ec2.EnableFastSnapshotRestoreErrorItem) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		for _, v := range apiObject.FastSnapshotRestoreStateErrors {
+			if v == nil {
+				continue
+			}
+
+			if err := EnableFastSnapshotRestoreStateError(v.Error); err != nil {
+				errs = append(errs, fmt.Errorf("%s %s: %w", aws.StringValue(apiObject.SnapshotId), aws.StringValue(v.AvailabilityZone), err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func UnsuccessfulItemError(apiObject *ec2.UnsuccessfulItemError) error {
 	if apiObject == nil {
 		return nil
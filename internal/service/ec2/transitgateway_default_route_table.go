@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// transitGatewayDefaultRouteTableOption is a Transit Gateway default route table option,
+// either the association or the propagation default route table.
+type transitGatewayDefaultRouteTableOption struct {
+	// name is used in diagnostics, e.g. "Association".
+	name string
+	get  func(*ec2.TransitGatewayOptions) *string
+	set  func(*ec2.ModifyTransitGatewayOptions, string)
+}
+
+var (
+	transitGatewayDefaultRouteTableAssociationOption = transitGatewayDefaultRouteTableOption{
+		name: "Association",
+		get: func(apiObject *ec2.TransitGatewayOptions) *string {
+			return apiObject.AssociationDefaultRouteTableId
+		},
+		set: func(apiObject *ec2.ModifyTransitGatewayOptions, v string) {
+			apiObject.AssociationDefaultRouteTableId = aws.String(v)
+		},
+	}
+	transitGatewayDefaultRouteTablePropagationOption = transitGatewayDefaultRouteTableOption{
+		name: "Propagation",
+		get: func(apiObject *ec2.TransitGatewayOptions) *string {
+			return apiObject.PropagationDefaultRouteTableId
+		},
+		set: func(apiObject *ec2.ModifyTransitGatewayOptions, v string) {
+			apiObject.PropagationDefaultRouteTableId = aws.String(v)
+		},
+	}
+)
+
+func (o transitGatewayDefaultRouteTableOption) resourceName() string {
+	return "EC2 Transit Gateway Default Route Table " + o.name
+}
+
+// resourceTransitGatewayDefaultRouteTable returns a resource that manages the specified default route table option.
+func resourceTransitGatewayDefaultRouteTable(option transitGatewayDefaultRouteTableOption) *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceTransitGatewayDefaultRouteTableCreate(ctx, d, meta, option)
+		},
+		ReadWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceTransitGatewayDefaultRouteTableRead(ctx, d, meta, option)
+		},
+		UpdateWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceTransitGatewayDefaultRouteTableUpdate(ctx, d, meta, option)
+		},
+		DeleteWithoutTimeout: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return resourceTransitGatewayDefaultRouteTableDelete(ctx, d, meta, option)
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTransitGatewayDefaultRouteTableImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"original_default_route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transit_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transit_gateway_route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayDefaultRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, option transitGatewayDefaultRouteTableOption) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	transitGatewayID := d.Get("transit_gateway_id").(string)
+	transitGateway, err := FindTransitGatewayByID(ctx, conn, transitGatewayID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway (%s): %s", transitGatewayID, err)
+	}
+
+	if transitGateway.Options != nil {
+		d.Set("original_default_route_table_id", option.get(transitGateway.Options))
+	}
+
+	if err := modifyTransitGatewayDefaultRouteTable(ctx, conn, option, transitGatewayID, d.Get("transit_gateway_route_table_id").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating %s (%s): %s", option.resourceName(), transitGatewayID, err)
+	}
+
+	d.SetId(transitGatewayID)
+
+	return append(diags, resourceTransitGatewayDefaultRouteTableRead(ctx, d, meta, option)...)
+}
+
+func resourceTransitGatewayDefaultRouteTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}, option transitGatewayDefaultRouteTableOption) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	transitGateway, err := FindTransitGatewayByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] %s %s not found, removing from state", option.resourceName(), d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading %s (%s): %s", option.resourceName(), d.Id(), err)
+	}
+
+	d.Set("transit_gateway_id", transitGateway.TransitGatewayId)
+	if transitGateway.Options != nil {
+		d.Set("transit_gateway_route_table_id", option.get(transitGateway.Options))
+	} else {
+		d.Set("transit_gateway_route_table_id", nil)
+	}
+
+	return diags
+}
+
+func resourceTransitGatewayDefaultRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}, option transitGatewayDefaultRouteTableOption) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	if d.HasChange("transit_gateway_route_table_id") {
+		if err := modifyTransitGatewayDefaultRouteTable(ctx, conn, option, d.Id(), d.Get("transit_gateway_route_table_id").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating %s (%s): %s", option.resourceName(), d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTransitGatewayDefaultRouteTableRead(ctx, d, meta, option)...)
+}
+
+func resourceTransitGatewayDefaultRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}, option transitGatewayDefaultRouteTableOption) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	originalRouteTableID := d.Get("original_default_route_table_id").(string)
+	if originalRouteTableID == "" {
+		return sdkdiag.AppendWarningf(diags, "%s (%s): original default route table is unknown, leaving default route table (%s) in place. Import the resource with the original default route table ID to restore it on destroy.", option.resourceName(), d.Id(), d.Get("transit_gateway_route_table_id").(string))
+	}
+
+	log.Printf("[DEBUG] Deleting %s: %s", option.resourceName(), d.Id())
+	err := modifyTransitGatewayDefaultRouteTable(ctx, conn, option, d.Id(), originalRouteTableID, d.Timeout(schema.TimeoutDelete))
+
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting %s (%s): %s", option.resourceName(), d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceTransitGatewayDefaultRouteTableImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	transitGatewayID, originalRouteTableID, err := TransitGatewayDefaultRouteTableParseImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(transitGatewayID)
+	d.Set("original_default_route_table_id", originalRouteTableID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+const transitGatewayDefaultRouteTableImportIDSeparator = "_"
+
+// TransitGatewayDefaultRouteTableParseImportID parses an import ID of the form
+// transit-gateway-id[_original-default-route-table-id].
+func TransitGatewayDefaultRouteTableParseImportID(id string) (string, string, error) {
+	parts := strings.Split(id, transitGatewayDefaultRouteTableImportIDSeparator)
+
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return parts[0], "", nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected transit-gateway-id or transit-gateway-id%[2]soriginal-default-route-table-id", id, transitGatewayDefaultRouteTableImportIDSeparator)
+}
+
+func modifyTransitGatewayDefaultRouteTable(ctx context.Context, conn *ec2.EC2, option transitGatewayDefaultRouteTableOption, transitGatewayID, transitGatewayRouteTableID string, timeout time.Duration) error {
+	input := &ec2.ModifyTransitGatewayInput{
+		Options:          &ec2.ModifyTransitGatewayOptions{},
+		TransitGatewayId: aws.String(transitGatewayID),
+	}
+	option.set(input.Options, transitGatewayRouteTableID)
+
+	if _, err := conn.ModifyTransitGatewayWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := WaitTransitGatewayUpdated(ctx, conn, transitGatewayID, timeout); err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestTransitGatewayDefaultRouteTableParseImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		id                                     string
+		transitGatewayID, originalRouteTableID string
+		expectError                            bool
+	}{
+		{id: "", expectError: true},
+		{id: "_tgw-rtb-1", expectError: true},
+		{id: "tgw-1_", expectError: true},
+		{id: "tgw-1_tgw-rtb-1_x", expectError: true},
+		{id: "tgw-1", transitGatewayID: "tgw-1"},
+		{id: "tgw-1_tgw-rtb-1", transitGatewayID: "tgw-1", originalRouteTableID: "tgw-rtb-1"},
+	}
+
+	for _, testCase := range testCases {
+		transitGatewayID, originalRouteTableID, err := tfec2.TransitGatewayDefaultRouteTableParseImportID(testCase.id)
+
+		if testCase.expectError {
+			if err == nil {
+				t.Errorf("%q: expected error", testCase.id)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", testCase.id, err)
+			continue
+		}
+
+		if transitGatewayID != testCase.transitGatewayID || originalRouteTableID != testCase.originalRouteTableID {
+			t.Errorf("%q: got (%q, %q), want (%q, %q)", testCase.id, transitGatewayID, originalRouteTableID, testCase.transitGatewayID, testCase.originalRouteTableID)
+		}
+	}
+}
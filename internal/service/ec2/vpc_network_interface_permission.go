@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_network_interface_permission")
+func ResourceNetworkInterfacePermission() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceNetworkInterfacePermissionCreate,
+		ReadWithoutTimeout:   resourceNetworkInterfacePermissionRead,
+		DeleteWithoutTimeout: resourceNetworkInterfacePermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"aws_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"network_interface_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ec2.InterfacePermissionType_Values(), false),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkInterfacePermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	input := &ec2.CreateNetworkInterfacePermissionInput{
+		AwsAccountId:       aws.String(d.Get("aws_account_id").(string)),
+		NetworkInterfaceId: aws.String(d.Get("network_interface_id").(string)),
+		Permission:         aws.String(d.Get("permission").(string)),
+	}
+
+	output, err := conn.CreateNetworkInterfacePermissionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Network Interface Permission: %s", err)
+	}
+
+	d.SetId(aws.StringValue(output.InterfacePermission.NetworkInterfacePermissionId))
+
+	if _, err := WaitNetworkInterfacePermissionGranted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Network Interface Permission (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceNetworkInterfacePermissionRead(ctx, d, meta)...)
+}
+
+func resourceNetworkInterfacePermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	permission, err := FindNetworkInterfacePermissionByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Network Interface Permission %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Network Interface Permission (%s): %s", d.Id(), err)
+	}
+
+	d.Set("aws_account_id", permission.AwsAccountId)
+	d.Set("network_interface_id", permission.NetworkInterfaceId)
+	d.Set("permission", permission.Permission)
+	if permission.PermissionState != nil {
+		d.Set("state", permission.PermissionState.State)
+	} else {
+		d.Set("state", nil)
+	}
+
+	return diags
+}
+
+func resourceNetworkInterfacePermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	log.Printf("[INFO] Deleting EC2 Network Interface Permission: %s", d.Id())
+	_, err := conn.DeleteNetworkInterfacePermissionWithContext(ctx, &ec2.DeleteNetworkInterfacePermissionInput{
+		NetworkInterfacePermissionId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidPermissionIDNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Network Interface Permission (%s): %s", d.Id(), err)
+	}
+
+	if _, err := WaitNetworkInterfacePermissionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Network Interface Permission (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
@@ -157,3 +157,53 @@ func FindService(ctx context.Context, conn *ecs.ECS, input *ecs.DescribeServices
 
 	return output.Services[0], nil
 }
+
+// findServiceStoppedTasks returns stopped tasks of an ECS Service.
+// If a deployment ID is specified only the tasks started by that deployment are returned.
+func findServiceStoppedTasks(ctx context.Context, conn *ecs.ECS, cluster, serviceName, deploymentID string) ([]*ecs.Task, error) {
+	input := &ecs.ListTasksInput{
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		MaxResults:    aws.Int64(100),
+	}
+
+	// A service's tasks are started by its deployment's ID, which is unique to the service.
+	if deploymentID != "" {
+		input.StartedBy = aws.String(deploymentID)
+	} else {
+		input.ServiceName = aws.String(serviceName)
+	}
+
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	output, err := conn.ListTasksWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TaskArns) == 0 {
+		return nil, nil
+	}
+
+	describeInput := &ecs.DescribeTasksInput{
+		Tasks: output.TaskArns,
+	}
+
+	if cluster != "" {
+		describeInput.Cluster = aws.String(cluster)
+	}
+
+	describeOutput, err := conn.DescribeTasksWithContext(ctx, describeInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if describeOutput == nil {
+		return nil, nil
+	}
+
+	return describeOutput.Tasks, nil
+}
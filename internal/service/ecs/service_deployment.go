@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+const (
+	serviceDeploymentStatusPrimary = "PRIMARY"
+
+	// Maximum number of service events and stopped tasks reported when waiting for a deployment fails.
+	serviceDeploymentMaxEvents       = 10
+	serviceDeploymentMaxStoppedTasks = 5
+)
+
+// serviceDeploymentFailedError is returned when the deployment being waited on fails.
+type serviceDeploymentFailedError struct {
+	deploymentID string
+	reason       string
+}
+
+func (e *serviceDeploymentFailedError) Error() string {
+	return fmt.Sprintf("deployment (%s) failed: %s", e.deploymentID, e.reason)
+}
+
+// serviceDeploymentRolledBackError is returned when the deployment being waited on fails
+// and the deployment circuit breaker rolls the service back to its previous deployment.
+type serviceDeploymentRolledBackError struct {
+	deploymentID string
+	reason       string
+}
+
+func (e *serviceDeploymentRolledBackError) Error() string {
+	return fmt.Sprintf("deployment (%s) failed and was rolled back by the deployment circuit breaker: %s", e.deploymentID, e.reason)
+}
+
+// serviceDeploymentReplacedError is returned when the deployment being waited on is replaced
+// by another deployment before it completes, e.g. by a deployment started outside Terraform.
+type serviceDeploymentReplacedError struct {
+	deploymentID string
+	reason       string
+}
+
+func (e *serviceDeploymentReplacedError) Error() string {
+	return fmt.Sprintf("deployment (%s) was %s", e.deploymentID, e.reason)
+}
+
+// serviceDeploymentTracker follows an ECS Service deployment across successive DescribeServices calls.
+// It logs service events as they appear and decides whether the deployment has failed or completed.
+type serviceDeploymentTracker struct {
+	// deploymentID is the ID of the deployment started by the last create or update, if known.
+	deploymentID string
+	// waitForRollout requires the deployment to be PRIMARY with rollout state COMPLETED.
+	waitForRollout bool
+
+	since      time.Time
+	seenEvents map[string]struct{}
+	events     []*ecs.ServiceEvent
+	service    *ecs.Service
+}
+
+func newServiceDeploymentTracker(deploymentID string, waitForRollout bool) *serviceDeploymentTracker {
+	return &serviceDeploymentTracker{
+		deploymentID:   deploymentID,
+		waitForRollout: waitForRollout,
+		since:          time.Now(),
+		seenEvents:     make(map[string]struct{}),
+	}
+}
+
+// observe records the latest state of the service and logs any service events not seen before.
+func (t *serviceDeploymentTracker) observe(service *ecs.Service) {
+	t.service = service
+
+	// Service events are returned newest first.
+	for i := len(service.Events) - 1; i >= 0; i-- {
+		event := service.Events[i]
+		id := aws.StringValue(event.Id)
+
+		if _, ok := t.seenEvents[id]; ok {
+			continue
+		}
+
+		t.seenEvents[id] = struct{}{}
+
+		if aws.TimeValue(event.CreatedAt).Before(t.since) {
+			continue
+		}
+
+		log.Printf("[INFO] ECS Service (%s) event: %s", aws.StringValue(service.ServiceArn), aws.StringValue(event.Message))
+		t.events = append(t.events, event)
+	}
+}
+
+// deploymentError returns a non-nil error if the tracked deployment has failed or been replaced.
+func (t *serviceDeploymentTracker) deploymentError(service *ecs.Service) error {
+	if t.deploymentID == "" {
+		return nil
+	}
+
+	deployment := findServiceDeploymentByID(service, t.deploymentID)
+
+	if deployment == nil {
+		primary := findServiceDeploymentByStatus(service, serviceDeploymentStatusPrimary)
+		if primary == nil {
+			return nil
+		}
+
+		return &serviceDeploymentReplacedError{
+			deploymentID: t.deploymentID,
+			reason:       fmt.Sprintf("replaced by deployment (%s) of task definition %s", aws.StringValue(primary.Id), aws.StringValue(primary.TaskDefinition)),
+		}
+	}
+
+	if aws.StringValue(deployment.RolloutState) != ecs.DeploymentRolloutStateFailed {
+		return nil
+	}
+
+	reason := aws.StringValue(deployment.RolloutStateReason)
+
+	if v := service.DeploymentConfiguration; v != nil && v.DeploymentCircuitBreaker != nil && aws.BoolValue(v.DeploymentCircuitBreaker.Rollback) {
+		return &serviceDeploymentRolledBackError{
+			deploymentID: t.deploymentID,
+			reason:       reason,
+		}
+	}
+
+	return &serviceDeploymentFailedError{
+		deploymentID: t.deploymentID,
+		reason:       reason,
+	}
+}
+
+// stable returns whether the service has a single deployment with all desired tasks running.
+func (t *serviceDeploymentTracker) stable(service *ecs.Service) bool {
+	if len(service.Deployments) != 1 || aws.Int64Value(service.DesiredCount) != aws.Int64Value(service.RunningCount) {
+		return false
+	}
+
+	deployment := service.Deployments[0]
+
+	if t.deploymentID != "" && aws.StringValue(deployment.Id) != t.deploymentID {
+		return false
+	}
+
+	if t.waitForRollout {
+		if aws.StringValue(deployment.Status) != serviceDeploymentStatusPrimary {
+			return false
+		}
+
+		// The rollout state is only reported for rolling update deployments.
+		if v := deployment.RolloutState; v != nil && aws.StringValue(v) != ecs.DeploymentRolloutStateCompleted {
+			return false
+		}
+	}
+
+	return true
+}
+
+// diagnostics returns the service events and stopped task reasons seen while waiting,
+// to help explain why a deployment did not complete.
+func (t *serviceDeploymentTracker) diagnostics(ctx context.Context, conn *ecs.ECS, cluster string) error {
+	var lines []string
+
+	events := t.events
+	if n := len(events); n > serviceDeploymentMaxEvents {
+		events = events[n-serviceDeploymentMaxEvents:]
+	}
+
+	if len(events) > 0 {
+		lines = append(lines, "service events:")
+		for _, event := range events {
+			lines = append(lines, fmt.Sprintf("  %s %s", aws.TimeValue(event.CreatedAt).Format(time.RFC3339), aws.StringValue(event.Message)))
+		}
+	}
+
+	if t.service != nil {
+		tasks, err := findServiceStoppedTasks(ctx, conn, cluster, aws.StringValue(t.service.ServiceName), t.deploymentID)
+
+		if err != nil {
+			log.Printf("[WARN] reading ECS Service (%s) stopped tasks: %s", aws.StringValue(t.service.ServiceArn), err)
+		}
+
+		var reasons []string
+		for _, task := range tasks {
+			if len(reasons) == serviceDeploymentMaxStoppedTasks {
+				break
+			}
+
+			reasons = append(reasons, stoppedTaskReason(task))
+		}
+
+		if len(reasons) > 0 {
+			lines = append(lines, "stopped tasks:")
+			for _, reason := range reasons {
+				lines = append(lines, "  "+reason)
+			}
+		}
+	}
+
+	if len(lines) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(lines, "\n"))
+}
+
+func findServiceDeploymentByID(service *ecs.Service, id string) *ecs.Deployment {
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.Id) == id {
+			return deployment
+		}
+	}
+
+	return nil
+}
+
+func findServiceDeploymentByStatus(service *ecs.Service, status string) *ecs.Deployment {
+	for _, deployment := range service.Deployments {
+		if aws.StringValue(deployment.Status) == status {
+			return deployment
+		}
+	}
+
+	return nil
+}
+
+// primaryServiceDeploymentID returns the ID of the service's PRIMARY deployment, if any.
+func primaryServiceDeploymentID(service *ecs.Service) string {
+	if service == nil {
+		return ""
+	}
+
+	if deployment := findServiceDeploymentByStatus(service, serviceDeploymentStatusPrimary); deployment != nil {
+		return aws.StringValue(deployment.Id)
+	}
+
+	return ""
+}
+
+func stoppedTaskReason(task *ecs.Task) string {
+	reason := fmt.Sprintf("%s (%s): %s", aws.StringValue(task.TaskArn), aws.StringValue(task.StopCode), aws.StringValue(task.StoppedReason))
+
+	for _, container := range task.Containers {
+		if container.Reason == nil && container.ExitCode == nil {
+			continue
+		}
+
+		reason += fmt.Sprintf("; container %s", aws.StringValue(container.Name))
+		if container.ExitCode != nil {
+			reason += fmt.Sprintf(" exited with code %d", aws.Int64Value(container.ExitCode))
+		}
+		if container.Reason != nil {
+			reason += fmt.Sprintf(": %s", aws.StringValue(container.Reason))
+		}
+	}
+
+	return reason
+}
@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestServiceDeploymentTracker(t *testing.T) {
+	t.Parallel()
+
+	withCircuitBreaker := func(service *ecs.Service, rollback bool) *ecs.Service {
+		service.DeploymentConfiguration = &ecs.DeploymentConfiguration{
+			DeploymentCircuitBreaker: &ecs.DeploymentCircuitBreaker{
+				Enable:   aws.Bool(true),
+				Rollback: aws.Bool(rollback),
+			},
+		}
+		return service
+	}
+
+	testCases := map[string]struct {
+		deploymentID   string
+		waitForRollout bool
+		service        *ecs.Service
+		expectStable   bool
+		expectFailed   bool
+		expectRollback bool
+		expectReplaced bool
+	}{
+		"untracked stable": {
+			service: &ecs.Service{
+				DesiredCount: aws.Int64(2),
+				RunningCount: aws.Int64(2),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("PRIMARY")},
+				},
+			},
+			expectStable: true,
+		},
+		"untracked pending tasks": {
+			service: &ecs.Service{
+				DesiredCount: aws.Int64(2),
+				RunningCount: aws.Int64(1),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("PRIMARY")},
+				},
+			},
+		},
+		"tracked in progress": {
+			deploymentID: "ecs-svc/2",
+			service: &ecs.Service{
+				DesiredCount: aws.Int64(1),
+				RunningCount: aws.Int64(1),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress)},
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("ACTIVE"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted)},
+				},
+			},
+		},
+		"tracked stable": {
+			deploymentID: "ecs-svc/2",
+			service: &ecs.Service{
+				DesiredCount: aws.Int64(1),
+				RunningCount: aws.Int64(1),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress)},
+				},
+			},
+			expectStable: true,
+		},
+		"tracked rollout in progress": {
+			deploymentID:   "ecs-svc/2",
+			waitForRollout: true,
+			service: &ecs.Service{
+				DesiredCount: aws.Int64(1),
+				RunningCount: aws.Int64(1),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress)},
+				},
+			},
+		},
+		"tracked rollout completed": {
+			deploymentID:   "ecs-svc/2",
+			waitForRollout: true,
+			service: &ecs.Service{
+				DesiredCount: aws.Int64(1),
+				RunningCount: aws.Int64(1),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted)},
+				},
+			},
+			expectStable: true,
+		},
+		"tracked failed": {
+			deploymentID: "ecs-svc/2",
+			service: withCircuitBreaker(&ecs.Service{
+				DesiredCount: aws.Int64(1),
+				RunningCount: aws.Int64(1),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateFailed), RolloutStateReason: aws.String("tasks failed to start")},
+				},
+			}, false),
+			expectFailed: true,
+		},
+		"tracked rolled back": {
+			deploymentID: "ecs-svc/2",
+			service: withCircuitBreaker(&ecs.Service{
+				DesiredCount: aws.Int64(1),
+				RunningCount: aws.Int64(0),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/3"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress)},
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("ACTIVE"), RolloutState: aws.String(ecs.DeploymentRolloutStateFailed), RolloutStateReason: aws.String("tasks failed to start")},
+				},
+			}, true),
+			expectRollback: true,
+		},
+		"tracked replaced": {
+			deploymentID: "ecs-svc/2",
+			service: &ecs.Service{
+				DesiredCount: aws.Int64(1),
+				RunningCount: aws.Int64(1),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/3"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted)},
+				},
+			},
+			expectReplaced: true,
+		},
+		"tracked replaced with rollback": {
+			deploymentID: "ecs-svc/2",
+			service: withCircuitBreaker(&ecs.Service{
+				DesiredCount: aws.Int64(1),
+				RunningCount: aws.Int64(1),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/3"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted)},
+				},
+			}, true),
+			expectReplaced: true,
+		},
+		"tracked in progress with rollback": {
+			deploymentID: "ecs-svc/2",
+			service: withCircuitBreaker(&ecs.Service{
+				DesiredCount: aws.Int64(1),
+				RunningCount: aws.Int64(0),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress)},
+				},
+			}, true),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tracker := newServiceDeploymentTracker(testCase.deploymentID, testCase.waitForRollout)
+			tracker.observe(testCase.service)
+
+			err := tracker.deploymentError(testCase.service)
+
+			var failedErr *serviceDeploymentFailedError
+			if got, want := errors.As(err, &failedErr), testCase.expectFailed; got != want {
+				t.Errorf("deployment failed error = %t (%v), want %t", got, err, want)
+			}
+
+			var rolledBackErr *serviceDeploymentRolledBackError
+			if got, want := errors.As(err, &rolledBackErr), testCase.expectRollback; got != want {
+				t.Errorf("deployment rolled back error = %t (%v), want %t", got, err, want)
+			}
+
+			var replacedErr *serviceDeploymentReplacedError
+			if got, want := errors.As(err, &replacedErr), testCase.expectReplaced; got != want {
+				t.Errorf("deployment replaced error = %t (%v), want %t", got, err, want)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got, want := tracker.stable(testCase.service), testCase.expectStable; got != want {
+				t.Errorf("stable = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestServiceDeploymentTrackerObserve(t *testing.T) {
+	t.Parallel()
+
+	tracker := newServiceDeploymentTracker("", false)
+	before := tracker.since.Add(-time.Minute)
+	after := tracker.since.Add(time.Second)
+
+	tracker.observe(&ecs.Service{
+		Events: []*ecs.ServiceEvent{
+			{Id: aws.String("2"), CreatedAt: aws.Time(after), Message: aws.String("has started 1 tasks")},
+			{Id: aws.String("1"), CreatedAt: aws.Time(before), Message: aws.String("has reached a steady state")},
+		},
+	})
+	tracker.observe(&ecs.Service{
+		Events: []*ecs.ServiceEvent{
+			{Id: aws.String("3"), CreatedAt: aws.Time(after.Add(time.Second)), Message: aws.String("has stopped 1 running tasks")},
+			{Id: aws.String("2"), CreatedAt: aws.Time(after), Message: aws.String("has started 1 tasks")},
+			{Id: aws.String("1"), CreatedAt: aws.Time(before), Message: aws.String("has reached a steady state")},
+		},
+	})
+
+	var got []string
+	for _, event := range tracker.events {
+		got = append(got, aws.StringValue(event.Id))
+	}
+
+	if want := []string{"2", "3"}; len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("events = %v, want %v", got, want)
+	}
+}
+
+func TestStoppedTaskReason(t *testing.T) {
+	t.Parallel()
+
+	task := &ecs.Task{
+		TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789012:task/example/0123456789abcdef"), //lintignore:AWSAT003,AWSAT005
+		StopCode:      aws.String(ecs.TaskStopCodeEssentialContainerExited),
+		StoppedReason: aws.String("Essential container in task exited"),
+		Containers: []*ecs.Container{
+			{Name: aws.String("app"), ExitCode: aws.Int64(1), Reason: aws.String("OutOfMemoryError: Container killed due to memory usage")},
+			{Name: aws.String("sidecar")},
+		},
+	}
+
+	want := "arn:aws:ecs:us-west-2:123456789012:task/example/0123456789abcdef (EssentialContainerExited): Essential container in task exited; container app exited with code 1: OutOfMemoryError: Container killed due to memory usage" //lintignore:AWSAT003,AWSAT005
+
+	if got := stoppedTaskReason(task); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
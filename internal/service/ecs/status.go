@@ -71,7 +71,7 @@ func statusServiceNoTags(ctx context.Context, conn *ecs.ECS, id, cluster string)
 	}
 }
 
-func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster string) retry.StateRefreshFunc {
+func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster string, tracker *serviceDeploymentTracker) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		serviceRaw, status, err := statusServiceNoTags(ctx, conn, id, cluster)()
 		if err != nil {
@@ -84,9 +84,13 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.ECS, id, cluster
 
 		service := serviceRaw.(*ecs.Service)
 
-		if d, dc, rc := len(service.Deployments),
-			aws.Int64Value(service.DesiredCount),
-			aws.Int64Value(service.RunningCount); d == 1 && dc == rc {
+		tracker.observe(service)
+
+		if err := tracker.deploymentError(service); err != nil {
+			return service, "", err
+		}
+
+		if tracker.stable(service) {
 			status = serviceStatusStable
 		} else {
 			status = serviceStatusPending
@@ -74,10 +74,13 @@ func ResourceTaskDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"container_definition": containerDefinitionSchema(),
 			"container_definitions": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"container_definition", "container_definitions"},
 				StateFunc: func(v interface{}) string {
 					// Sort the lists of environment variables as they are serialized to state, so we won't get
 					// spurious reorderings in plans (diff is suppressed if the environment variables haven't changed,
@@ -448,10 +451,16 @@ func resourceTaskDefinitionCreate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ECSConn(ctx)
 
-	rawDefinitions := d.Get("container_definitions").(string)
-	definitions, err := expandContainerDefinitions(rawDefinitions)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating ECS Task Definition (%s): %s", d.Get("family").(string), err)
+	var definitions []*ecs.ContainerDefinition
+	if v, ok := d.GetOk("container_definition"); ok && len(v.([]interface{})) > 0 {
+		definitions = expandTaskDefinitionContainerDefinitions(v.([]interface{}))
+	} else {
+		rawDefinitions := d.Get("container_definitions").(string)
+		var err error
+		definitions, err = expandContainerDefinitions(rawDefinitions)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating ECS Task Definition (%s): %s", d.Get("family").(string), err)
+		}
 	}
 
 	input := &ecs.RegisterTaskDefinitionInput{
@@ -610,6 +619,18 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading ECS Task Definition (%s): %s", d.Id(), err)
 	}
+	// The typed block is only populated if it can represent every container, so that switching
+	// from container_definitions to equivalent container_definition blocks, or back, is not a change.
+	containerDefinition := flattenTaskDefinitionContainerDefinitions(taskDefinition.ContainerDefinitions)
+	for _, apiObject := range taskDefinition.ContainerDefinitions {
+		if fields := containerDefinitionUnsupportedFields(apiObject); len(fields) > 0 {
+			log.Printf("[DEBUG] ECS Task Definition (%s) container (%s) sets %s, which container_definition does not support", d.Id(), aws.StringValue(apiObject.Name), strings.Join(fields, ", "))
+			containerDefinition = nil
+		}
+	}
+	if err := d.Set("container_definition", containerDefinition); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting container_definition: %s", err)
+	}
 
 	d.Set("task_role_arn", taskDefinition.TaskRoleArn)
 	d.Set("execution_role_arn", taskDefinition.ExecutionRoleArn)
@@ -0,0 +1,657 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const (
+	// Defaults applied by ECS to container definition fields that are not specified.
+	containerDefinitionHealthCheckIntervalDefault = 30
+	containerDefinitionHealthCheckRetriesDefault  = 3
+	containerDefinitionHealthCheckTimeoutDefault  = 5
+)
+
+// containerDefinitionSchema returns the schema of the container_definition block,
+// a typed alternative to the container_definitions JSON document.
+// Defaults mirror those ECS applies so that reading a task definition back does not produce a diff.
+// The block is Computed so that it is populated when container_definitions is used.
+func containerDefinitionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeList,
+		Optional:         true,
+		Computed:         true,
+		ForceNew:         true,
+		DiffSuppressFunc: suppressEquivalentContainerDefinition,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"command": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"cpu": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"depends_on": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"condition": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ContainerCondition_Values(), false),
+							},
+							"container_name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"entry_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"environment": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"essential": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  true,
+				},
+				"health_check": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								MinItems: 1,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"interval": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      containerDefinitionHealthCheckIntervalDefault,
+								ValidateFunc: validation.IntBetween(5, 300),
+							},
+							"retries": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      containerDefinitionHealthCheckRetriesDefault,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"start_period": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							"timeout": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      containerDefinitionHealthCheckTimeoutDefault,
+								ValidateFunc: validation.IntBetween(2, 120),
+							},
+						},
+					},
+				},
+				"image": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"log_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_driver": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+							},
+							"options": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"secret_option": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem:     containerDefinitionSecretResource(),
+							},
+						},
+					},
+				},
+				"memory": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"memory_reservation": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"port_mapping": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"app_protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringInSlice(ecs.ApplicationProtocol_Values(), false),
+							},
+							"container_port": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(0, 65535),
+							},
+							// ECS sets the host port to the container port in awsvpc and host network modes.
+							"host_port": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(0, 65535),
+							},
+							"name": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"protocol": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      ecs.TransportProtocolTcp,
+								ValidateFunc: validation.StringInSlice(ecs.TransportProtocol_Values(), false),
+							},
+						},
+					},
+				},
+				"secret": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     containerDefinitionSecretResource(),
+				},
+				"user": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"working_directory": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func containerDefinitionSecretResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value_from": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// suppressEquivalentContainerDefinition suppresses changes to the container_definition block that
+// leave the registered containers unchanged, e.g. replacing container_definitions with an equivalent block.
+// The suppression applies to every attribute of the block.
+func suppressEquivalentContainerDefinition(k, old, new string, d *schema.ResourceData) bool {
+	registered, _ := d.GetChange("container_definitions")
+	networkMode, ok := d.GetOk("network_mode")
+	isAWSVPC := ok && networkMode.(string) == ecs.NetworkModeAwsvpc
+
+	return containerDefinitionBlockIsEquivalent(registered.(string), d.Get("container_definition").([]interface{}), isAWSVPC)
+}
+
+// containerDefinitionBlockIsEquivalent reports whether the containers of a container_definition block
+// are equivalent to the registered container definitions JSON document.
+func containerDefinitionBlockIsEquivalent(registered string, tfList []interface{}, isAWSVPC bool) bool {
+	if registered == "" || len(tfList) == 0 {
+		return false
+	}
+
+	configured, err := flattenContainerDefinitions(expandTaskDefinitionContainerDefinitions(tfList))
+
+	if err != nil {
+		return false
+	}
+
+	equal, _ := ContainerDefinitionsAreEquivalent(registered, configured, isAWSVPC)
+
+	return equal
+}
+
+func expandTaskDefinitionContainerDefinitions(tfList []interface{}) []*ecs.ContainerDefinition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*ecs.ContainerDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, expandTaskDefinitionContainerDefinition(tfMap))
+	}
+
+	return apiObjects
+}
+
+func expandTaskDefinitionContainerDefinition(tfMap map[string]interface{}) *ecs.ContainerDefinition {
+	apiObject := &ecs.ContainerDefinition{
+		Essential: aws.Bool(tfMap["essential"].(bool)),
+		Image:     aws.String(tfMap["image"].(string)),
+		Name:      aws.String(tfMap["name"].(string)),
+	}
+
+	if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Command = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+		apiObject.Cpu = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+		apiObject.DependsOn = expandContainerDefinitionDependsOn(v)
+	}
+
+	if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+		apiObject.EntryPoint = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["environment"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Environment = expandContainerDefinitionEnvironment(v)
+	}
+
+	if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HealthCheck = expandContainerDefinitionHealthCheck(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LogConfiguration = expandLogConfiguration(v)
+	}
+
+	if v, ok := tfMap["memory"].(int); ok && v != 0 {
+		apiObject.Memory = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+		apiObject.MemoryReservation = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["port_mapping"].([]interface{}); ok && len(v) > 0 {
+		apiObject.PortMappings = expandContainerDefinitionPortMappings(v)
+	}
+
+	if v, ok := tfMap["secret"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Secrets = expandSecretOptions(v)
+	}
+
+	if v, ok := tfMap["user"].(string); ok && v != "" {
+		apiObject.User = aws.String(v)
+	}
+
+	if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+		apiObject.WorkingDirectory = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandContainerDefinitionDependsOn(tfList []interface{}) []*ecs.ContainerDependency {
+	var apiObjects []*ecs.ContainerDependency
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &ecs.ContainerDependency{
+			Condition:     aws.String(tfMap["condition"].(string)),
+			ContainerName: aws.String(tfMap["container_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerDefinitionEnvironment(tfMap map[string]interface{}) []*ecs.KeyValuePair {
+	var apiObjects []*ecs.KeyValuePair
+
+	for k, v := range tfMap {
+		apiObjects = append(apiObjects, &ecs.KeyValuePair{
+			Name:  aws.String(k),
+			Value: aws.String(v.(string)),
+		})
+	}
+
+	sort.Slice(apiObjects, func(i, j int) bool {
+		return aws.StringValue(apiObjects[i].Name) < aws.StringValue(apiObjects[j].Name)
+	})
+
+	return apiObjects
+}
+
+func expandContainerDefinitionHealthCheck(tfMap map[string]interface{}) *ecs.HealthCheck {
+	apiObject := &ecs.HealthCheck{
+		Command:  flex.ExpandStringList(tfMap["command"].([]interface{})),
+		Interval: aws.Int64(int64(tfMap["interval"].(int))),
+		Retries:  aws.Int64(int64(tfMap["retries"].(int))),
+		Timeout:  aws.Int64(int64(tfMap["timeout"].(int))),
+	}
+
+	if v, ok := tfMap["start_period"].(int); ok && v != 0 {
+		apiObject.StartPeriod = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandContainerDefinitionPortMappings(tfList []interface{}) []*ecs.PortMapping {
+	var apiObjects []*ecs.PortMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.PortMapping{
+			ContainerPort: aws.Int64(int64(tfMap["container_port"].(int))),
+			Protocol:      aws.String(tfMap["protocol"].(string)),
+		}
+
+		if v, ok := tfMap["app_protocol"].(string); ok && v != "" {
+			apiObject.AppProtocol = aws.String(v)
+		}
+
+		if v, ok := tfMap["host_port"].(int); ok && v != 0 {
+			apiObject.HostPort = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenTaskDefinitionContainerDefinitions(apiObjects []*ecs.ContainerDefinition) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenTaskDefinitionContainerDefinition(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenTaskDefinitionContainerDefinition(apiObject *ecs.ContainerDefinition) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"command":            aws.StringValueSlice(apiObject.Command),
+		"cpu":                aws.Int64Value(apiObject.Cpu),
+		"depends_on":         flattenContainerDefinitionDependsOn(apiObject.DependsOn),
+		"entry_point":        aws.StringValueSlice(apiObject.EntryPoint),
+		"environment":        flattenContainerDefinitionEnvironment(apiObject.Environment),
+		"essential":          aws.BoolValue(apiObject.Essential),
+		"image":              aws.StringValue(apiObject.Image),
+		"memory":             aws.Int64Value(apiObject.Memory),
+		"memory_reservation": aws.Int64Value(apiObject.MemoryReservation),
+		"name":               aws.StringValue(apiObject.Name),
+		"port_mapping":       flattenContainerDefinitionPortMappings(apiObject.PortMappings),
+		"secret":             flattenContainerDefinitionSecrets(apiObject.Secrets),
+		"user":               aws.StringValue(apiObject.User),
+		"working_directory":  aws.StringValue(apiObject.WorkingDirectory),
+	}
+
+	// Essential defaults to true when not specified.
+	if apiObject.Essential == nil {
+		tfMap["essential"] = true
+	}
+
+	if v := apiObject.HealthCheck; v != nil {
+		tfMap["health_check"] = []interface{}{flattenContainerDefinitionHealthCheck(v)}
+	}
+
+	if v := apiObject.LogConfiguration; v != nil {
+		tfMap["log_configuration"] = []interface{}{flattenContainerDefinitionLogConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+// containerDefinitionUnsupportedFields returns the names of the fields of apiObject that are set to
+// values the container_definition block cannot represent.
+// Empty lists and false, which ECS returns for fields that are not specified, are treated as not set.
+func containerDefinitionUnsupportedFields(apiObject *ecs.ContainerDefinition) []string {
+	var fields []string
+
+	for _, v := range []struct {
+		name string
+		set  bool
+	}{
+		{"credentialSpecs", len(apiObject.CredentialSpecs) > 0},
+		{"disableNetworking", aws.BoolValue(apiObject.DisableNetworking)},
+		{"dnsSearchDomains", len(apiObject.DnsSearchDomains) > 0},
+		{"dnsServers", len(apiObject.DnsServers) > 0},
+		{"dockerLabels", len(apiObject.DockerLabels) > 0},
+		{"dockerSecurityOptions", len(apiObject.DockerSecurityOptions) > 0},
+		{"environmentFiles", len(apiObject.EnvironmentFiles) > 0},
+		{"extraHosts", len(apiObject.ExtraHosts) > 0},
+		{"firelensConfiguration", apiObject.FirelensConfiguration != nil},
+		{"hostname", aws.StringValue(apiObject.Hostname) != ""},
+		{"interactive", aws.BoolValue(apiObject.Interactive)},
+		{"links", len(apiObject.Links) > 0},
+		{"linuxParameters", apiObject.LinuxParameters != nil},
+		{"mountPoints", len(apiObject.MountPoints) > 0},
+		{"privileged", aws.BoolValue(apiObject.Privileged)},
+		{"pseudoTerminal", aws.BoolValue(apiObject.PseudoTerminal)},
+		{"readonlyRootFilesystem", aws.BoolValue(apiObject.ReadonlyRootFilesystem)},
+		{"repositoryCredentials", apiObject.RepositoryCredentials != nil},
+		{"resourceRequirements", len(apiObject.ResourceRequirements) > 0},
+		{"startTimeout", aws.Int64Value(apiObject.StartTimeout) != 0},
+		{"stopTimeout", aws.Int64Value(apiObject.StopTimeout) != 0},
+		{"systemControls", len(apiObject.SystemControls) > 0},
+		{"ulimits", len(apiObject.Ulimits) > 0},
+		{"volumesFrom", len(apiObject.VolumesFrom) > 0},
+	} {
+		if v.set {
+			fields = append(fields, v.name)
+		}
+	}
+
+	return fields
+}
+
+func flattenContainerDefinitionDependsOn(apiObjects []*ecs.ContainerDependency) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"condition":      aws.StringValue(apiObject.Condition),
+			"container_name": aws.StringValue(apiObject.ContainerName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerDefinitionEnvironment(apiObjects []*ecs.KeyValuePair) map[string]interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	tfMap := make(map[string]interface{}, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap[aws.StringValue(apiObject.Name)] = aws.StringValue(apiObject.Value)
+	}
+
+	return tfMap
+}
+
+func flattenContainerDefinitionHealthCheck(apiObject *ecs.HealthCheck) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"command":      aws.StringValueSlice(apiObject.Command),
+		"interval":     containerDefinitionHealthCheckIntervalDefault,
+		"retries":      containerDefinitionHealthCheckRetriesDefault,
+		"start_period": aws.Int64Value(apiObject.StartPeriod),
+		"timeout":      containerDefinitionHealthCheckTimeoutDefault,
+	}
+
+	if v := apiObject.Interval; v != nil {
+		tfMap["interval"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Retries; v != nil {
+		tfMap["retries"] = aws.Int64Value(v)
+	}
+
+	if v := apiObject.Timeout; v != nil {
+		tfMap["timeout"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
+
+func flattenContainerDefinitionLogConfiguration(apiObject *ecs.LogConfiguration) map[string]interface{} {
+	return map[string]interface{}{
+		"log_driver":    aws.StringValue(apiObject.LogDriver),
+		"options":       aws.StringValueMap(apiObject.Options),
+		"secret_option": flattenContainerDefinitionSecrets(apiObject.SecretOptions),
+	}
+}
+
+func flattenContainerDefinitionPortMappings(apiObjects []*ecs.PortMapping) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"app_protocol":   aws.StringValue(apiObject.AppProtocol),
+			"container_port": aws.Int64Value(apiObject.ContainerPort),
+			"host_port":      aws.Int64Value(apiObject.HostPort),
+			"name":           aws.StringValue(apiObject.Name),
+			"protocol":       ecs.TransportProtocolTcp,
+		}
+
+		if v := apiObject.Protocol; v != nil {
+			tfMap["protocol"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenContainerDefinitionSecrets(apiObjects []*ecs.Secret) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":       aws.StringValue(apiObject.Name),
+			"value_from": aws.StringValue(apiObject.ValueFrom),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandTaskDefinitionContainerDefinitions(t *testing.T) {
+	t.Parallel()
+
+	tfList := []interface{}{
+		map[string]interface{}{
+			"command":     []interface{}{"nginx", "-g", "daemon off;"},
+			"cpu":         128,
+			"depends_on":  []interface{}{map[string]interface{}{"condition": ecs.ContainerConditionStart, "container_name": "init"}},
+			"entry_point": []interface{}{},
+			"environment": map[string]interface{}{"B": "2", "A": "1"},
+			"essential":   true,
+			"health_check": []interface{}{map[string]interface{}{
+				"command":      []interface{}{"CMD", "true"},
+				"interval":     30,
+				"retries":      3,
+				"start_period": 0,
+				"timeout":      5,
+			}},
+			"image": "nginx:latest",
+			"log_configuration": []interface{}{map[string]interface{}{
+				"log_driver":    ecs.LogDriverAwslogs,
+				"options":       map[string]interface{}{"awslogs-group": "test"},
+				"secret_option": []interface{}{},
+			}},
+			"memory":             256,
+			"memory_reservation": 0,
+			"name":               "web",
+			"port_mapping": []interface{}{map[string]interface{}{
+				"app_protocol":   "",
+				"container_port": 80,
+				"host_port":      0,
+				"name":           "",
+				"protocol":       ecs.TransportProtocolTcp,
+			}},
+			"secret":            []interface{}{map[string]interface{}{"name": "TOKEN", "value_from": "arn:aws:ssm:us-west-2:123456789012:parameter/token"}}, //lintignore:AWSAT003,AWSAT005
+			"user":              "",
+			"working_directory": "/srv",
+		},
+	}
+
+	want := []*ecs.ContainerDefinition{
+		{
+			Command:   aws.StringSlice([]string{"nginx", "-g", "daemon off;"}),
+			Cpu:       aws.Int64(128),
+			DependsOn: []*ecs.ContainerDependency{{Condition: aws.String(ecs.ContainerConditionStart), ContainerName: aws.String("init")}},
+			Environment: []*ecs.KeyValuePair{
+				{Name: aws.String("A"), Value: aws.String("1")},
+				{Name: aws.String("B"), Value: aws.String("2")},
+			},
+			Essential: aws.Bool(true),
+			HealthCheck: &ecs.HealthCheck{
+				Command:  aws.StringSlice([]string{"CMD", "true"}),
+				Interval: aws.Int64(30),
+				Retries:  aws.Int64(3),
+				Timeout:  aws.Int64(5),
+			},
+			Image: aws.String("nginx:latest"),
+			LogConfiguration: &ecs.LogConfiguration{
+				LogDriver: aws.String(ecs.LogDriverAwslogs),
+				Options:   aws.StringMap(map[string]string{"awslogs-group": "test"}),
+			},
+			Memory:       aws.Int64(256),
+			Name:         aws.String("web"),
+			PortMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(80), Protocol: aws.String(ecs.TransportProtocolTcp)}},
+			Secrets: []*ecs.Secret{
+				{Name: aws.String("TOKEN"), ValueFrom: aws.String("arn:aws:ssm:us-west-2:123456789012:parameter/token")}, //lintignore:AWSAT003,AWSAT005
+			},
+			WorkingDirectory: aws.String("/srv"),
+		},
+	}
+
+	got := expandTaskDefinitionContainerDefinitions(tfList)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFlattenTaskDefinitionContainerDefinitions(t *testing.T) {
+	t.Parallel()
+
+	// Fields omitted by the API are normalized to the defaults ECS applies.
+	apiObjects := []*ecs.ContainerDefinition{
+		{
+			HealthCheck:  &ecs.HealthCheck{Command: aws.StringSlice([]string{"CMD", "true"})},
+			Image:        aws.String("busybox"),
+			Name:         aws.String("sidecar"),
+			PortMappings: []*ecs.PortMapping{{ContainerPort: aws.Int64(8080), HostPort: aws.Int64(8080)}},
+		},
+	}
+
+	want := []interface{}{
+		map[string]interface{}{
+			"command":     []string{},
+			"cpu":         int64(0),
+			"depends_on":  []interface{}(nil),
+			"entry_point": []string{},
+			"environment": map[string]interface{}(nil),
+			"essential":   true,
+			"health_check": []interface{}{map[string]interface{}{
+				"command":      []string{"CMD", "true"},
+				"interval":     containerDefinitionHealthCheckIntervalDefault,
+				"retries":      containerDefinitionHealthCheckRetriesDefault,
+				"start_period": int64(0),
+				"timeout":      containerDefinitionHealthCheckTimeoutDefault,
+			}},
+			"image":              "busybox",
+			"memory":             int64(0),
+			"memory_reservation": int64(0),
+			"name":               "sidecar",
+			"port_mapping": []interface{}{map[string]interface{}{
+				"app_protocol":   "",
+				"container_port": int64(8080),
+				"host_port":      int64(8080),
+				"name":           "",
+				"protocol":       ecs.TransportProtocolTcp,
+			}},
+			"secret":            []interface{}(nil),
+			"user":              "",
+			"working_directory": "",
+		},
+	}
+
+	got := flattenTaskDefinitionContainerDefinitions(apiObjects)
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestContainerDefinitionBlockIsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	// Container definitions as returned by ECS, with the defaults it applies.
+	registered := `[
+  {
+    "name": "web",
+    "image": "nginx:latest",
+    "cpu": 128,
+    "memory": 256,
+    "essential": true,
+    "environment": [
+      {"name": "FOO", "value": "bar"},
+      {"name": "BAR", "value": "qux"}
+    ],
+    "portMappings": [
+      {"containerPort": 80, "hostPort": 80, "protocol": "tcp"}
+    ],
+    "healthCheck": {
+      "command": ["CMD-SHELL", "curl -f http://localhost/ || exit 1"],
+      "interval": 30,
+      "retries": 3,
+      "timeout": 5
+    },
+    "mountPoints": [],
+    "volumesFrom": []
+  },
+  {
+    "name": "init",
+    "image": "busybox:latest",
+    "memory": 64,
+    "essential": false,
+    "command": ["true"],
+    "mountPoints": [],
+    "portMappings": [],
+    "volumesFrom": []
+  }
+]`
+
+	apiObjects, err := expandContainerDefinitions(registered)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Round trip through the schema, as reading the task definition into state and planning does.
+	d := ResourceTaskDefinition().TestResourceData()
+
+	if err := d.Set("container_definition", flattenTaskDefinitionContainerDefinitions(apiObjects)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tfList := d.Get("container_definition").([]interface{})
+
+	if !containerDefinitionBlockIsEquivalent(registered, tfList, true) {
+		t.Error("expected round-tripped container definitions to be equivalent")
+	}
+
+	tfList[0].(map[string]interface{})["environment"] = map[string]interface{}{"FOO": "baz", "BAR": "qux"}
+
+	if containerDefinitionBlockIsEquivalent(registered, tfList, true) {
+		t.Error("expected changed container definitions not to be equivalent")
+	}
+
+	if containerDefinitionBlockIsEquivalent("", tfList, true) {
+		t.Error("expected container definitions not to be equivalent to an unregistered task definition")
+	}
+}
+
+func TestContainerDefinitionUnsupportedFields(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		apiObject *ecs.ContainerDefinition
+		want      []string
+	}{
+		"supported only": {
+			apiObject: &ecs.ContainerDefinition{
+				Cpu:   aws.Int64(0),
+				Image: aws.String("nginx:latest"),
+				Name:  aws.String("web"),
+			},
+		},
+		"API defaults": {
+			apiObject: &ecs.ContainerDefinition{
+				Image:        aws.String("nginx:latest"),
+				MountPoints:  []*ecs.MountPoint{},
+				Name:         aws.String("web"),
+				Privileged:   aws.Bool(false),
+				Ulimits:      []*ecs.Ulimit{},
+				VolumesFrom:  []*ecs.VolumeFrom{},
+				StopTimeout:  aws.Int64(0),
+				DockerLabels: map[string]*string{},
+			},
+		},
+		"unsupported": {
+			apiObject: &ecs.ContainerDefinition{
+				Image:       aws.String("nginx:latest"),
+				MountPoints: []*ecs.MountPoint{{ContainerPath: aws.String("/data"), SourceVolume: aws.String("data")}},
+				Name:        aws.String("web"),
+				Privileged:  aws.Bool(true),
+				Ulimits:     []*ecs.Ulimit{{Name: aws.String(ecs.UlimitNameNofile), HardLimit: aws.Int64(1024), SoftLimit: aws.Int64(1024)}},
+			},
+			want: []string{"mountPoints", "privileged", "ulimits"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(containerDefinitionUnsupportedFields(testCase.apiObject), testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
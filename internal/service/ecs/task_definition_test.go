@@ -106,6 +106,136 @@ func TestAccECSTaskDefinition_basic(t *testing.T) {
 	})
 }
 
+func TestAccECSTaskDefinition_containerDefinitionBlock(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinitionBlock(rName, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.name", "web"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.essential", "true"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.FOO", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.container_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.host_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.interval", "30"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.retries", "3"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.timeout", "5"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.0.container_name", "init"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.0.condition", "SUCCESS"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.log_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.log_configuration.0.log_driver", "awslogs"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.name", "init"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.essential", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "container_definitions"),
+				),
+			},
+			{
+				Config:   testAccTaskDefinitionConfig_containerDefinitionBlock(rName, "bar"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinitionBlock(rName, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.FOO", "baz"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccTaskDefinitionImportStateIdFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_containerDefinitionsUnsupportedFields(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				// The containers set links, which container_definition does not support.
+				Config: testAccTaskDefinitionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_containerDefinitionBlockMigration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def ecs.TaskDefinition
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ecs.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinitionsEquivalentToBlock(rName, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.name", "web"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.name", "init"),
+				),
+			},
+			{
+				// Replacing container_definitions with equivalent container_definition blocks doesn't replace the task definition.
+				Config:   testAccTaskDefinitionConfig_containerDefinitionBlock(rName, "bar"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinitionBlock(rName, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.FOO", "baz"),
+				),
+			},
+			{
+				// Replacing container_definition blocks with an equivalent container_definitions document doesn't either.
+				Config:   testAccTaskDefinitionConfig_containerDefinitionsEquivalentToBlock(rName, "baz"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 // Regression for https://github.com/hashicorp/terraform/issues/2370
 func TestAccECSTaskDefinition_scratchVolume(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -2811,3 +2941,121 @@ TASK_DEFINITION
 }
 `, rName)
 }
+
+func testAccTaskDefinitionConfig_containerDefinitionBlock(rName, envValue string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family       = %[1]q
+  network_mode = "awsvpc"
+
+  container_definition {
+    name   = "web"
+    image  = "nginx:latest"
+    cpu    = 128
+    memory = 256
+
+    environment = {
+      FOO = %[2]q
+      BAR = "qux"
+    }
+
+    port_mapping {
+      container_port = 80
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+    }
+
+    depends_on {
+      container_name = "init"
+      condition      = "SUCCESS"
+    }
+
+    log_configuration {
+      log_driver = "awslogs"
+
+      options = {
+        awslogs-group         = aws_cloudwatch_log_group.test.name
+        awslogs-region        = data.aws_region.current.name
+        awslogs-stream-prefix = "web"
+      }
+    }
+  }
+
+  container_definition {
+    name      = "init"
+    image     = "busybox:latest"
+    memory    = 64
+    essential = false
+    command   = ["true"]
+  }
+}
+
+data "aws_region" "current" {}
+`, rName, envValue)
+}
+
+func testAccTaskDefinitionConfig_containerDefinitionsEquivalentToBlock(rName, envValue string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudwatch_log_group" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family       = %[1]q
+  network_mode = "awsvpc"
+
+  container_definitions = jsonencode([
+    {
+      name   = "web"
+      image  = "nginx:latest"
+      cpu    = 128
+      memory = 256
+
+      environment = [
+        { name = "FOO", value = %[2]q },
+        { name = "BAR", value = "qux" },
+      ]
+
+      portMappings = [
+        { containerPort = 80 },
+      ]
+
+      healthCheck = {
+        command  = ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+        interval = 30
+        retries  = 3
+        timeout  = 5
+      }
+
+      dependsOn = [
+        { containerName = "init", condition = "SUCCESS" },
+      ]
+
+      logConfiguration = {
+        logDriver = "awslogs"
+        options = {
+          awslogs-group         = aws_cloudwatch_log_group.test.name
+          awslogs-region        = data.aws_region.current.name
+          awslogs-stream-prefix = "web"
+        }
+      }
+    },
+    {
+      name      = "init"
+      image     = "busybox:latest"
+      memory    = 64
+      essential = false
+      command   = ["true"]
+    },
+  ])
+}
+
+data "aws_region" "current" {}
+`, rName, envValue)
+}
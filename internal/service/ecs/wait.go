@@ -5,11 +5,13 @@ package ecs
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -66,24 +68,30 @@ func waitCapacityProviderUpdated(ctx context.Context, conn *ecs.ECS, arn string)
 }
 
 // waitServiceStable waits for an ECS Service to reach the status "ACTIVE" and have all desired tasks running. Does not return tags.
-func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster string, timeout time.Duration) (*ecs.Service, error) {
-	input := &ecs.DescribeServicesInput{
-		Services: aws.StringSlice([]string{id}),
-	}
-
-	if cluster != "" {
-		input.Cluster = aws.String(cluster)
-	}
+// If deploymentID is set, waiting stops with an error as soon as that deployment fails or is rolled back.
+// If waitForRollout is set, that deployment must also be the PRIMARY deployment with its rollout COMPLETED.
+func waitServiceStable(ctx context.Context, conn *ecs.ECS, id, cluster, deploymentID string, waitForRollout bool, timeout time.Duration) (*ecs.Service, error) {
+	tracker := newServiceDeploymentTracker(deploymentID, waitForRollout)
 
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{serviceStatusInactive, serviceStatusDraining, serviceStatusPending},
 		Target:  []string{serviceStatusStable},
-		Refresh: statusServiceWaitForStable(ctx, conn, id, cluster),
+		Refresh: statusServiceWaitForStable(ctx, conn, id, cluster, tracker),
 		Timeout: timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
+	if err != nil {
+		if diagnostics := tracker.diagnostics(ctx, conn, cluster); diagnostics != nil {
+			if tfresource.TimedOut(err) {
+				tfresource.SetLastError(err, diagnostics)
+			} else {
+				err = errors.Join(err, diagnostics)
+			}
+		}
+	}
+
 	if v, ok := outputRaw.(*ecs.Service); ok {
 		return v, err
 	}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_eks_cluster_kubeconfig")
+func DataSourceClusterKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceClusterKubeconfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"auth_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      KubeconfigAuthModeExec,
+				ValidateFunc: validation.StringInSlice(KubeconfigAuthMode_Values(), false),
+			},
+			"certificate_authority_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"context_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kubeconfig": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token_expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceClusterKubeconfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient)
+	conn := client.EKSConn(ctx)
+
+	name := d.Get("name").(string)
+	cluster, err := FindClusterByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s): %s", name, err)
+	}
+
+	authMode := d.Get("auth_mode").(string)
+	roleARN := d.Get("role_arn").(string)
+	input := kubeconfigInput{
+		AuthMode:    authMode,
+		ClusterARN:  aws.StringValue(cluster.Arn),
+		ClusterName: name,
+		ContextName: d.Get("context_name").(string),
+		Endpoint:    aws.StringValue(cluster.Endpoint),
+		Region:      client.Region,
+		RoleARN:     roleARN,
+	}
+	if cluster.CertificateAuthority != nil {
+		input.CertificateAuthorityData = aws.StringValue(cluster.CertificateAuthority.Data)
+	}
+
+	var token Token
+	if authMode == KubeconfigAuthModeToken {
+		stsConn := client.STSConn(ctx)
+		if roleARN != "" {
+			stsConn = sts.New(client.Session, stsConn.Config.Copy(&aws.Config{
+				Credentials: stscreds.NewCredentials(client.Session, roleARN),
+			}))
+		}
+
+		generator, err := NewGenerator(false, false)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "getting token generator: %s", err)
+		}
+
+		token, err = generator.GetWithSTS(ctx, name, stsConn)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "getting token: %s", err)
+		}
+
+		input.Token = token.Token
+	}
+
+	kubeconfig, err := renderKubeconfig(input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "rendering kubeconfig for EKS Cluster (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("certificate_authority_data", input.CertificateAuthorityData)
+	d.Set("endpoint", input.Endpoint)
+	d.Set("kubeconfig", kubeconfig)
+	if authMode == KubeconfigAuthModeToken {
+		d.Set("token", token.Token)
+		d.Set("token_expiration", token.Expiration.Format(time.RFC3339))
+	} else {
+		d.Set("token", nil)
+		d.Set("token_expiration", nil)
+	}
+
+	return diags
+}
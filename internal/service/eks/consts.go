@@ -21,6 +21,18 @@ func Resources_Values() []string {
 	}
 }
 
+const (
+	KubeconfigAuthModeExec  = "exec"
+	KubeconfigAuthModeToken = "token"
+)
+
+func KubeconfigAuthMode_Values() []string {
+	return []string{
+		KubeconfigAuthModeExec,
+		KubeconfigAuthModeToken,
+	}
+}
+
 const (
 	propagationTimeout = 2 * time.Minute
 )
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	kubeconfigExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+	kubeconfigExecCommand    = "aws"
+)
+
+// The types below model the subset of the kubeconfig format
+// (k8s.io/client-go/tools/clientcmd/api/v1) needed to reach an EKS cluster.
+// Field order is fixed so that rendering is deterministic.
+
+type kubeconfig struct {
+	APIVersion     string              `yaml:"apiVersion"`
+	Kind           string              `yaml:"kind"`
+	Clusters       []kubeconfigCluster `yaml:"clusters"`
+	Contexts       []kubeconfigContext `yaml:"contexts"`
+	CurrentContext string              `yaml:"current-context"`
+	Preferences    struct{}            `yaml:"preferences"`
+	Users          []kubeconfigUser    `yaml:"users"`
+}
+
+type kubeconfigCluster struct {
+	Name    string `yaml:"name"`
+	Cluster struct {
+		Server                   string `yaml:"server"`
+		CertificateAuthorityData string `yaml:"certificate-authority-data"`
+	} `yaml:"cluster"`
+}
+
+type kubeconfigContext struct {
+	Name    string `yaml:"name"`
+	Context struct {
+		Cluster string `yaml:"cluster"`
+		User    string `yaml:"user"`
+	} `yaml:"context"`
+}
+
+type kubeconfigUser struct {
+	Name string `yaml:"name"`
+	User struct {
+		Exec  *kubeconfigExec `yaml:"exec,omitempty"`
+		Token string          `yaml:"token,omitempty"`
+	} `yaml:"user"`
+}
+
+type kubeconfigExec struct {
+	APIVersion string   `yaml:"apiVersion"`
+	Command    string   `yaml:"command"`
+	Args       []string `yaml:"args"`
+}
+
+type kubeconfigInput struct {
+	AuthMode                 string
+	CertificateAuthorityData string
+	ClusterARN               string
+	ClusterName              string
+	ContextName              string
+	Endpoint                 string
+	Region                   string
+	RoleARN                  string
+	Token                    string
+}
+
+// renderKubeconfig returns a kubeconfig document for a single EKS cluster.
+// In exec mode credentials are obtained by the AWS CLI each time the
+// kubeconfig is used; in token mode the pre-generated token is embedded.
+func renderKubeconfig(in kubeconfigInput) (string, error) {
+	contextName := in.ContextName
+	if contextName == "" {
+		contextName = in.ClusterARN
+	}
+
+	cluster := kubeconfigCluster{Name: in.ClusterARN}
+	cluster.Cluster.Server = in.Endpoint
+	cluster.Cluster.CertificateAuthorityData = in.CertificateAuthorityData
+
+	currentContext := kubeconfigContext{Name: contextName}
+	currentContext.Context.Cluster = in.ClusterARN
+	currentContext.Context.User = in.ClusterARN
+
+	user := kubeconfigUser{Name: in.ClusterARN}
+	switch in.AuthMode {
+	case KubeconfigAuthModeToken:
+		user.User.Token = in.Token
+	default:
+		args := []string{"--region", in.Region, "eks", "get-token", "--cluster-name", in.ClusterName, "--output", "json"}
+		if in.RoleARN != "" {
+			args = append(args, "--role-arn", in.RoleARN)
+		}
+
+		user.User.Exec = &kubeconfigExec{
+			APIVersion: kubeconfigExecAPIVersion,
+			Command:    kubeconfigExecCommand,
+			Args:       args,
+		}
+	}
+
+	output, err := yaml.Marshal(kubeconfig{
+		APIVersion:     "v1",
+		Kind:           "Config",
+		Clusters:       []kubeconfigCluster{cluster},
+		Contexts:       []kubeconfigContext{currentContext},
+		CurrentContext: contextName,
+		Users:          []kubeconfigUser{user},
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
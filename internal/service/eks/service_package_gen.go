@@ -41,6 +41,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceClusterAuth,
 			TypeName: "aws_eks_cluster_auth",
 		},
+		{
+			Factory:  DataSourceClusterKubeconfig,
+			TypeName: "aws_eks_cluster_kubeconfig",
+		},
 		{
 			Factory:  DataSourceClusters,
 			TypeName: "aws_eks_clusters",
@@ -169,7 +169,7 @@ func ResourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -208,7 +208,7 @@ func ResourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -291,7 +291,7 @@ func ResourceFunction() *schema.Resource {
 			"s3_bucket": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket"},
+				ExactlyOneOf: []string{"filename", "image_uri", "s3_bucket", "source_dir"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -302,7 +302,26 @@ func ResourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir"},
+			},
+			"runtime_management_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"runtime_version_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validRuntimeVersionARN(),
+						},
+						"update_runtime_on": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.UpdateRuntimeOn](),
+						},
+					},
+				},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -340,6 +359,17 @@ func ResourceFunction() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ExactlyOneOf:  []string{"filename", "image_uri", "s3_bucket", "source_dir"},
+				ConflictsWith: []string{"source_code_hash"},
+			},
+			"source_dir_s3_bucket": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"source_dir"},
+			},
 			"source_code_size": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -415,7 +445,11 @@ func ResourceFunction() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			updateSourceCodeHashFromSourceDir,
 			checkHandlerRuntimeForZipFunction,
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				return validateRuntimeManagementConfig(d, "runtime_management_config.0.update_runtime_on", "runtime_management_config.0.runtime_version_arn")
+			},
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -459,6 +493,18 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Code.ZipFile = zipFile
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
+	} else if v, ok := d.GetOk("source_dir"); ok {
+		// Grab an exclusive lock so that we're only packaging one function in memory at a time.
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		code, err := expandFunctionCodeFromSourceDir(ctx, meta, v.(string), d.Get("source_code_hash").(string), d.Get("source_dir_s3_bucket").(string), functionName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", v, err)
+		}
+
+		input.Code = code
 	} else {
 		input.Code.S3Bucket = aws.String(d.Get("s3_bucket").(string))
 		input.Code.S3Key = aws.String(d.Get("s3_key").(string))
@@ -571,6 +617,15 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v, ok := d.GetOk("runtime_management_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := expandRuntimeManagementConfig(v.([]interface{})[0].(map[string]interface{}))
+		input.FunctionName = aws.String(d.Id())
+
+		if _, err := conn.PutRuntimeManagementConfig(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) runtime management config: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceFunctionRead(ctx, d, meta)...)
 }
 
@@ -647,6 +702,26 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 	d.Set("role", function.Role)
 	d.Set("runtime", function.Runtime)
+	// Runtime management is only supported for functions using a managed runtime.
+	// The configuration is only read when managed, as reading it requires an additional permission.
+	if v, ok := d.GetOk("runtime_management_config"); ok && len(v.([]interface{})) > 0 && function.PackageType == types.PackageTypeZip {
+		var qualifier string
+		if hasQualifier {
+			qualifier = aws.ToString(function.Version)
+		}
+
+		output, err := FindRuntimeManagementConfigByTwoPartKey(ctx, conn, d.Id(), qualifier)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) runtime management config: %s", d.Id(), err)
+		}
+
+		if err := d.Set("runtime_management_config", flattenRuntimeManagementConfig(output)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting runtime_management_config: %s", err)
+		}
+	} else {
+		d.Set("runtime_management_config", nil)
+	}
 	d.Set("signing_job_arn", function.SigningJobArn)
 	d.Set("signing_profile_version_arn", function.SigningProfileVersionArn)
 	// Support in-place update of non-refreshable attribute.
@@ -908,6 +983,20 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.ZipFile = zipFile
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
+		} else if v, ok := d.GetOk("source_dir"); ok {
+			// Grab an exclusive lock so that we're only packaging one function in memory at a time.
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			code, err := expandFunctionCodeFromSourceDir(ctx, meta, v.(string), d.Get("source_code_hash").(string), d.Get("source_dir_s3_bucket").(string), d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "packaging source directory (%s): %s", v, err)
+			}
+
+			input.S3Bucket = code.S3Bucket
+			input.S3Key = code.S3Key
+			input.ZipFile = code.ZipFile
 		} else {
 			input.S3Bucket = aws.String(d.Get("s3_bucket").(string))
 			input.S3Key = aws.String(d.Get("s3_key").(string))
@@ -957,6 +1046,27 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("runtime_management_config") {
+		if v, ok := d.GetOk("runtime_management_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input := expandRuntimeManagementConfig(v.([]interface{})[0].(map[string]interface{}))
+			input.FunctionName = aws.String(d.Id())
+
+			if _, err := conn.PutRuntimeManagementConfig(ctx, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) runtime management config: %s", d.Id(), err)
+			}
+		} else {
+			// Removing the block restores the default of automatic runtime updates.
+			input := &lambda.PutRuntimeManagementConfigInput{
+				FunctionName:    aws.String(d.Id()),
+				UpdateRuntimeOn: types.UpdateRuntimeOnAuto,
+			}
+
+			if _, err := conn.PutRuntimeManagementConfig(ctx, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "resetting Lambda Function (%s) runtime management config: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.Get("publish").(bool) && (codeUpdate || configUpdate || d.HasChange("publish")) {
 		input := &lambda.PublishVersionInput{
 			FunctionName: aws.String(d.Id()),
@@ -1413,6 +1523,31 @@ func flattenSnapStart(apiObject *types.SnapStartResponse) []interface{} {
 	return []interface{}{m}
 }
 
+func expandRuntimeManagementConfig(tfMap map[string]interface{}) *lambda.PutRuntimeManagementConfigInput {
+	apiObject := &lambda.PutRuntimeManagementConfigInput{
+		UpdateRuntimeOn: types.UpdateRuntimeOn(tfMap["update_runtime_on"].(string)),
+	}
+
+	if v, ok := tfMap["runtime_version_arn"].(string); ok && v != "" {
+		apiObject.RuntimeVersionArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenRuntimeManagementConfig(apiObject *lambda.GetRuntimeManagementConfigOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"runtime_version_arn": aws.ToString(apiObject.RuntimeVersionArn),
+		"update_runtime_on":   string(apiObject.UpdateRuntimeOn),
+	}
+
+	return []interface{}{tfMap}
+}
+
 func expandArchitectures(tfList []interface{}) []types.Architecture {
 	vs := make([]types.Architecture, 0, len(tfList))
 	for _, v := range tfList {
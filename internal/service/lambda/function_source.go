@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"compress/flate"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+const (
+	// Maximum size of a deployment package uploaded directly in the CreateFunction or UpdateFunctionCode request.
+	// Larger packages must be uploaded via S3.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	functionZipFileMaxDirectUploadSize = 50 * 1024 * 1024
+
+	sourceDirZipCompressionLevel = flate.BestCompression
+)
+
+var (
+	// All archive entries are given the earliest timestamp representable in a ZIP file
+	// so that the archive, and hence its hash, only depends on file names, modes and contents.
+	sourceDirZipModified = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// sourceDirFiles returns the paths of the regular files under dir, keyed by their slash-separated path relative to dir.
+// Symbolic links to files and directories are followed. A symbolic link to a directory containing it is an error.
+func sourceDirFiles(dir string) (map[string]string, error) {
+	files := make(map[string]string)
+
+	if err := walkSourceDir(dir, "", make(map[string]bool), files); err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", dir, err)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("source directory (%s) contains no files", dir)
+	}
+
+	return files, nil
+}
+
+func walkSourceDir(dir, prefix string, ancestors map[string]bool, files map[string]string) error {
+	realDir, err := filepath.EvalSymlinks(dir)
+
+	if err != nil {
+		return err
+	}
+
+	if ancestors[realDir] {
+		return fmt.Errorf("symbolic link cycle at %s", dir)
+	}
+
+	ancestors[realDir] = true
+	defer delete(ancestors, realDir)
+
+	entries, err := os.ReadDir(dir)
+
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		name := prefix + entry.Name()
+
+		// Follow symbolic links.
+		fi, err := os.Stat(path)
+
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case fi.IsDir():
+			if err := walkSourceDir(path, name+"/", ancestors, files); err != nil {
+				return err
+			}
+		case fi.Mode().IsRegular():
+			files[name] = path
+		default:
+			return fmt.Errorf("source file (%s) is not a regular file", path)
+		}
+	}
+
+	return nil
+}
+
+// writeSourceDirZip writes a deterministic ZIP archive of the regular files under dir to w.
+// Entries are sorted by path, have a fixed modification time, have their permissions
+// normalized to 0644, or 0755 if the file is executable by its owner, and are compressed
+// with a fixed compression level.
+func writeSourceDirZip(w io.Writer, dir string) error {
+	dir, err := homedir.Expand(dir)
+
+	if err != nil {
+		return err
+	}
+
+	files, err := sourceDirFiles(dir)
+
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	zw := zip.NewWriter(w)
+	zw.RegisterCompressor(zip.Deflate, func(out io.Writer) (io.WriteCloser, error) {
+		return flate.NewWriter(out, sourceDirZipCompressionLevel)
+	})
+
+	for _, name := range names {
+		if err := writeSourceDirZipEntry(zw, name, files[name]); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+func writeSourceDirZipEntry(zw *zip.Writer, name, path string) error {
+	f, err := os.Open(path)
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	fi, err := f.Stat()
+
+	if err != nil {
+		return err
+	}
+
+	header := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: sourceDirZipModified,
+	}
+
+	if fi.Mode().Perm()&0100 != 0 {
+		header.SetMode(0755)
+	} else {
+		header.SetMode(0644)
+	}
+
+	w, err := zw.CreateHeader(header)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(w, f)
+
+	return err
+}
+
+// sourceDirHash returns the base64-encoded SHA256 hash of the deployment package built from dir,
+// matching the CodeSha256 value returned by Lambda. The package is not held in memory.
+func sourceDirHash(dir string) (string, error) {
+	hash := sha256.New()
+
+	if err := writeSourceDirZip(hash, dir); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(hash.Sum(nil)), nil
+}
+
+// expandFunctionCodeFromSourceDir packages dir and returns the function code to deploy.
+// The package is built in a temporary file and must match sourceCodeHash, the hash computed during planning.
+// Packages too large to be uploaded directly are uploaded to bucket first.
+func expandFunctionCodeFromSourceDir(ctx context.Context, meta interface{}, dir, sourceCodeHash, bucket, functionName string) (*types.FunctionCode, error) {
+	f, err := os.CreateTemp("", "terraform-provider-aws-lambda-*.zip")
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		f.Close()
+
+		if err := os.Remove(f.Name()); err != nil {
+			log.Printf("[WARN] Error removing deployment package (%s): %s", f.Name(), err)
+		}
+	}()
+
+	hash := sha256.New()
+
+	if err := writeSourceDirZip(io.MultiWriter(f, hash), dir); err != nil {
+		return nil, err
+	}
+
+	sum := hash.Sum(nil)
+
+	if got := base64.StdEncoding.EncodeToString(sum); sourceCodeHash != "" && got != sourceCodeHash {
+		return nil, fmt.Errorf("deployment package hash (%s) does not match the planned source_code_hash (%s): %s changed after planning", got, sourceCodeHash, dir)
+	}
+
+	size, err := f.Seek(0, io.SeekCurrent)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if size <= functionZipFileMaxDirectUploadSize {
+		zipFile, err := os.ReadFile(f.Name())
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.FunctionCode{
+			ZipFile: zipFile,
+		}, nil
+	}
+
+	if bucket == "" {
+		return nil, fmt.Errorf("deployment package built from %s is %d bytes, larger than the %d byte direct upload limit: source_dir_s3_bucket must be set", dir, size, functionZipFileMaxDirectUploadSize)
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s/%s.zip", functionName, hex.EncodeToString(sum))
+
+	_, err = meta.(*conns.AWSClient).S3Client(ctx).PutObject(ctx, &s3.PutObjectInput{
+		Body:   f,
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("uploading deployment package to S3 (s3://%s/%s): %w", bucket, key, err)
+	}
+
+	return &types.FunctionCode{
+		S3Bucket: aws.String(bucket),
+		S3Key:    aws.String(key),
+	}, nil
+}
+
+// updateSourceCodeHashFromSourceDir plans a code update whenever the contents of source_dir change.
+// A source_dir that can't be packaged, e.g. because it is empty, is rejected during planning.
+func updateSourceCodeHashFromSourceDir(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("source_dir")
+
+	if !ok || !d.NewValueKnown("source_dir") {
+		return nil
+	}
+
+	hash, err := sourceDirHash(v.(string))
+
+	if err != nil {
+		return err
+	}
+
+	if d.Get("source_code_hash").(string) != hash {
+		return d.SetNew("source_code_hash", hash)
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSourceDirZip(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	writeFile := func(name string, contents string, perm os.FileMode) {
+		t.Helper()
+
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(contents), perm); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.Chmod(path, perm); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	writeFile("index.js", "exports.handler = async () => {};", 0600)
+	writeFile("lib/util.js", "module.exports = {};", 0664)
+	writeFile("bin/bootstrap", "#!/bin/sh", 0700)
+
+	var buf bytes.Buffer
+
+	if err := writeSourceDirZip(&buf, dir); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	wantEntries := []struct {
+		name string
+		mode os.FileMode
+	}{
+		{"bin/bootstrap", 0755},
+		{"index.js", 0644},
+		{"lib/util.js", 0644},
+	}
+
+	if got, want := len(r.File), len(wantEntries); got != want {
+		t.Fatalf("got %d entries, want %d", got, want)
+	}
+
+	for i, f := range r.File {
+		if got, want := f.Name, wantEntries[i].name; got != want {
+			t.Errorf("entry %d: got name %q, want %q", i, got, want)
+		}
+
+		if got, want := f.Mode().Perm(), wantEntries[i].mode; got != want {
+			t.Errorf("entry %s: got mode %s, want %s", f.Name, got, want)
+		}
+
+		if got, want := f.Modified.UTC(), sourceDirZipModified; !got.Equal(want) {
+			t.Errorf("entry %s: got modification time %s, want %s", f.Name, got, want)
+		}
+
+		if got, want := f.Method, zip.Deflate; got != want {
+			t.Errorf("entry %s: got method %d, want %d", f.Name, got, want)
+		}
+	}
+
+	first, err := sourceDirHash(dir)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if sum := sha256.Sum256(buf.Bytes()); first != base64.StdEncoding.EncodeToString(sum[:]) {
+		t.Errorf("got hash %s, want hash of the written package", first)
+	}
+
+	// Touching files must not change the archive.
+	later := time.Now().Add(time.Hour)
+
+	if err := os.Chtimes(filepath.Join(dir, "index.js"), later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := sourceDirHash(dir)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := second, first; got != want {
+		t.Errorf("got hash %s after touching a file, want %s", got, want)
+	}
+
+	// Changing file contents must change the archive.
+	writeFile("lib/util.js", "module.exports = { changed: true };", 0664)
+
+	third, err := sourceDirHash(dir)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, old := third, first; got == old {
+		t.Errorf("got unchanged hash %s after changing file contents", got)
+	}
+}
+
+func TestSourceDirZip_missingDirectory(t *testing.T) {
+	t.Parallel()
+
+	if _, err := sourceDirHash(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestSourceDirZip_emptyDirectory(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	if err := os.Mkdir(filepath.Join(dir, "empty"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sourceDirHash(dir); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestSourceDirFiles_symlinks(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	shared := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(shared, "util.js"), []byte("module.exports = {};"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "index.js"), []byte("exports.handler = async () => {};"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Symlink(shared, filepath.Join(dir, "lib")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Symlink(filepath.Join(dir, "index.js"), filepath.Join(dir, "main.js")); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := sourceDirFiles(dir)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, name := range []string{"index.js", "lib/util.js", "main.js"} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("missing file %s", name)
+		}
+	}
+
+	if got, want := len(files), 3; got != want {
+		t.Errorf("got %d files, want %d", got, want)
+	}
+
+	// A link to an ancestor directory would be followed forever.
+	if err := os.Symlink(dir, filepath.Join(shared, "loop")); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := sourceDirFiles(dir); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+// TestSourceDirZip_hash pins the package built for a fixed directory, so that any change to
+// the archive format, which would force a code update of every function using source_dir, is noticed.
+func TestSourceDirZip_hash(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	if err := os.MkdirAll(filepath.Join(dir, "lib"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "index.js"), []byte("exports.handler = async () => {};"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "lib", "util.js"), []byte("module.exports = {};"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := sourceDirHash(dir)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if want := "LSWoXnLutNyadNWd2Bniy1eqM1EC1d9AoZqFB+aLYD0="; got != want {
+		t.Errorf("got hash %s, want %s", got, want)
+	}
+}
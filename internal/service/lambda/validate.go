@@ -47,3 +47,10 @@ func validPolicyStatementID() schema.SchemaValidateFunc {
 		validation.StringLenBetween(1, 100),
 	)
 }
+
+func validRuntimeVersionARN() schema.SchemaValidateFunc {
+	// https://docs.aws.amazon.com/lambda/latest/dg/runtimes-update.html#runtime-management-identify
+	pattern := `^arn:[\w-]+:lambda:[a-z]{2}(?:-[a-z]+){1,2}-\d{1}::runtime:[0-9a-f]{64}$`
+
+	return validation.StringMatch(regexache.MustCompile(pattern), "must be a valid runtime version ARN")
+}
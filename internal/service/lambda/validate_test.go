@@ -162,3 +162,34 @@ func TestValidPolicyStatementID(t *testing.T) {
 		}
 	}
 }
+
+func TestValidRuntimeVersionARN(t *testing.T) {
+	t.Parallel()
+
+	validARNs := []string{
+		"arn:aws:lambda:us-east-1::runtime:0cdcfbdefbc5e7d3343f73c2e2dd3cba17d61dea0686b404502a0c9ce83931b9",            //lintignore:AWSAT003,AWSAT005
+		"arn:aws-us-gov:lambda:us-gov-west-1::runtime:0cdcfbdefbc5e7d3343f73c2e2dd3cba17d61dea0686b404502a0c9ce83931b9", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range validARNs {
+		_, errors := validRuntimeVersionARN()(v, "runtime_version_arn")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Lambda runtime version ARN: %q", v, errors)
+		}
+	}
+
+	invalidARNs := []string{
+		"",
+		"python3.11",
+		// Account ID is not part of a runtime version ARN.
+		"arn:aws:lambda:us-east-1:123456789012:runtime:0cdcfbdefbc5e7d3343f73c2e2dd3cba17d61dea0686b404502a0c9ce83931b9", //lintignore:AWSAT003,AWSAT005
+		// Truncated version hash.
+		"arn:aws:lambda:us-east-1::runtime:0cdcfbdefbc5e7d3",       //lintignore:AWSAT003,AWSAT005
+		"arn:aws:lambda:us-east-1:123456789012:function:ThumbNail", //lintignore:AWSAT003,AWSAT005
+	}
+	for _, v := range invalidARNs {
+		_, errors := validRuntimeVersionARN()(v, "runtime_version_arn")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Lambda runtime version ARN", v)
+		}
+	}
+}
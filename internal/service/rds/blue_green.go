@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
@@ -63,9 +64,10 @@ func (o *blueGreenOrchestrator) waitForDeploymentAvailable(ctx context.Context,
 	return dep, nil
 }
 
-func (o *blueGreenOrchestrator) switchover(ctx context.Context, identifier string, timeout time.Duration) (*types.BlueGreenDeployment, error) {
+func (o *blueGreenOrchestrator) switchover(ctx context.Context, identifier string, switchoverTimeout *int32, timeout time.Duration) (*types.BlueGreenDeployment, error) {
 	input := &rds_sdkv2.SwitchoverBlueGreenDeploymentInput{
 		BlueGreenDeploymentIdentifier: aws.String(identifier),
+		SwitchoverTimeout:             switchoverTimeout,
 	}
 	_, err := tfresource.RetryWhen(ctx, 10*time.Minute,
 		func() (interface{}, error) {
@@ -86,6 +88,28 @@ func (o *blueGreenOrchestrator) switchover(ctx context.Context, identifier strin
 	return dep, nil
 }
 
+func (o *blueGreenOrchestrator) deleteDeployment(ctx context.Context, identifier string, deleteTarget bool, timeout time.Duration) error {
+	input := &rds_sdkv2.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(identifier),
+	}
+	if deleteTarget {
+		input.DeleteTarget = aws.Bool(true)
+	}
+
+	_, err := o.conn.DeleteBlueGreenDeployment(ctx, input)
+	if errs.IsA[*types.BlueGreenDeploymentNotFoundFault](err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("deleting Blue/Green Deployment: %s", err)
+	}
+
+	if _, err := waitBlueGreenDeploymentDeleted(ctx, o.conn, identifier, timeout); err != nil {
+		return fmt.Errorf("deleting Blue/Green Deployment: waiting for completion: %s", err)
+	}
+	return nil
+}
+
 type instanceHandler struct {
 	conn *rds_sdkv2.Client
 }
@@ -159,3 +183,129 @@ func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d
 
 	return nil
 }
+
+type clusterHandler struct {
+	conn *rds_sdkv2.Client
+}
+
+func newClusterHandler(conn *rds_sdkv2.Client) *clusterHandler {
+	return &clusterHandler{
+		conn: conn,
+	}
+}
+
+func (h *clusterHandler) createBlueGreenInput(ctx context.Context, d *schema.ResourceData) (*rds_sdkv2.CreateBlueGreenDeploymentInput, error) {
+	input := &rds_sdkv2.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Id()),
+		Source:                  aws.String(d.Get("arn").(string)),
+	}
+
+	// The configured engine_version may be a version prefix, so the full version of the matching upgrade target is sent.
+	if from, to := clusterUpgradeSourceVersion(d), d.Get("engine_version").(string); d.HasChange("engine_version") && !clusterEngineVersionMatches(from, to) {
+		version, err := h.findUpgradeTarget(ctx, d.Get("engine").(string), from, to, d.Get("allow_major_version_upgrade").(bool))
+
+		if err != nil {
+			return nil, err
+		}
+
+		input.TargetEngineVersion = aws.String(version)
+	}
+	if d.HasChange("db_cluster_parameter_group_name") {
+		input.TargetDBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+	}
+	// DB instance parameter group name is not returned from the DescribeDBClusters API,
+	// so send the configured value whenever the Green environment's engine version changes.
+	if v, ok := d.GetOk("db_instance_parameter_group_name"); ok && d.HasChanges("db_instance_parameter_group_name", "engine_version") {
+		input.TargetDBParameterGroupName = aws.String(v.(string))
+	}
+
+	return input, nil
+}
+
+func (h *clusterHandler) switchoverTimeout(d *schema.ResourceData) *int32 {
+	if v, ok := d.GetOk("blue_green_update.0.switchover_timeout"); ok {
+		return aws.Int32(int32(v.(int)))
+	}
+
+	return nil
+}
+
+// clusterUpgradeSourceVersion returns the engine version that a Blue/Green upgrade starts from.
+// The configured engine_version may be a version prefix, e.g. "15", whereas upgrade targets
+// are described for an exact version, so engine_version_actual is preferred.
+func clusterUpgradeSourceVersion(d interface {
+	GetChange(key string) (interface{}, interface{})
+}) string {
+	if o, _ := d.GetChange("engine_version_actual"); o.(string) != "" {
+		return o.(string)
+	}
+
+	o, _ := d.GetChange("engine_version")
+
+	return o.(string)
+}
+
+// clusterEngineVersionMatches returns whether an engine version is equal to, or starts with, a configured version or version prefix.
+func clusterEngineVersionMatches(version, configured string) bool {
+	return version == configured || strings.HasPrefix(version, configured+".")
+}
+
+// findUpgradeTarget verifies that the cluster can be upgraded from one engine version to another,
+// using the same valid upgrade targets as exposed by the aws_rds_engine_version data source,
+// and returns the full engine version to upgrade to.
+func (h *clusterHandler) findUpgradeTarget(ctx context.Context, engine, from, to string, allowMajorVersionUpgrade bool) (string, error) {
+	input := &rds_sdkv2.DescribeDBEngineVersionsInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(from),
+		IncludeAll:    aws.Bool(true),
+	}
+
+	output, err := h.conn.DescribeDBEngineVersions(ctx, input)
+
+	if err != nil {
+		return "", fmt.Errorf("reading RDS Engine Version (%s/%s): %s", engine, from, err)
+	}
+
+	if output == nil || len(output.DBEngineVersions) == 0 {
+		return "", fmt.Errorf("reading RDS Engine Version (%s/%s): %s", engine, from, tfresource.NewEmptyResultError(input))
+	}
+
+	targets := output.DBEngineVersions[0].ValidUpgradeTarget
+	target := clusterUpgradeTarget(targets, to)
+
+	if target == nil {
+		var versions []string
+		for _, target := range targets {
+			versions = append(versions, aws.StringValue(target.EngineVersion))
+		}
+
+		return "", fmt.Errorf("%s is not a valid upgrade target for %s %s; valid targets are: %s", to, engine, from, strings.Join(versions, ", "))
+	}
+
+	if target.IsMajorVersionUpgrade && !allowMajorVersionUpgrade {
+		return "", fmt.Errorf(`upgrading %s from %s to %s is a major version upgrade: "allow_major_version_upgrade" must be set`, engine, from, to)
+	}
+
+	return aws.StringValue(target.EngineVersion), nil
+}
+
+// clusterUpgradeTarget returns the upgrade target matching a configured engine version.
+// A version prefix, e.g. "15" or "15.4", matches the last matching target, as targets are
+// returned in ascending version order, unless a target matches the configured version exactly.
+func clusterUpgradeTarget(targets []types.UpgradeTarget, configured string) *types.UpgradeTarget {
+	var match *types.UpgradeTarget
+
+	for i, target := range targets {
+		version := aws.StringValue(target.EngineVersion)
+
+		if version == configured {
+			return &targets[i]
+		}
+
+		if clusterEngineVersionMatches(version, configured) {
+			match = &targets[i]
+		}
+	}
+
+	return match
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go/aws"
+)
+
+type mockClusterUpgradeDiffer struct {
+	old map[string]string
+}
+
+func (d *mockClusterUpgradeDiffer) GetChange(key string) (interface{}, interface{}) {
+	return d.old[key], ""
+}
+
+func TestClusterUpgradeSourceVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		engineVersion       string
+		engineVersionActual string
+		expected            string
+	}{
+		"exact version": {
+			engineVersion:       "15.4",
+			engineVersionActual: "15.4",
+			expected:            "15.4",
+		},
+		"version prefix": {
+			engineVersion:       "15",
+			engineVersionActual: "15.4",
+			expected:            "15.4",
+		},
+		"aurora version prefix": {
+			engineVersion:       "8.0",
+			engineVersionActual: "8.0.mysql_aurora.3.04.0",
+			expected:            "8.0.mysql_aurora.3.04.0",
+		},
+		"no actual version": {
+			engineVersion: "15.4",
+			expected:      "15.4",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := &mockClusterUpgradeDiffer{
+				old: map[string]string{
+					"engine_version":        testCase.engineVersion,
+					"engine_version_actual": testCase.engineVersionActual,
+				},
+			}
+
+			if got, want := clusterUpgradeSourceVersion(d), testCase.expected; got != want {
+				t.Errorf("clusterUpgradeSourceVersion() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestClusterUpgradeTarget(t *testing.T) {
+	t.Parallel()
+
+	targets := []types.UpgradeTarget{
+		{EngineVersion: aws.String("15.4")},
+		{EngineVersion: aws.String("15.5")},
+		{EngineVersion: aws.String("16.1"), IsMajorVersionUpgrade: true},
+		{EngineVersion: aws.String("16.1.1"), IsMajorVersionUpgrade: true},
+	}
+
+	testCases := map[string]struct {
+		configured string
+		expected   string
+	}{
+		"exact version": {
+			configured: "15.4",
+			expected:   "15.4",
+		},
+		"major version prefix": {
+			configured: "15",
+			expected:   "15.5",
+		},
+		"exact version that is also a prefix": {
+			configured: "16.1",
+			expected:   "16.1",
+		},
+		"no match": {
+			configured: "17",
+		},
+		"partial component": {
+			configured: "15.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+			if target := clusterUpgradeTarget(targets, testCase.configured); target != nil {
+				got = aws.StringValue(target.EngineVersion)
+			}
+
+			if want := testCase.expected; got != want {
+				t.Errorf("clusterUpgradeTarget(%q) = %q, want %q", testCase.configured, got, want)
+			}
+		})
+	}
+}
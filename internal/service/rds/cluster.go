@@ -5,6 +5,7 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -29,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"golang.org/x/exp/slices"
 )
 
 const (
@@ -95,6 +97,25 @@ func ResourceCluster() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 259200),
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"switchover_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      300,
+							ValidateFunc: validation.IntBetween(30, 3600),
+						},
+					},
+				},
+			},
 			"cluster_identifier": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -521,6 +542,49 @@ func ResourceCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
+			func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				engine := d.Get("engine").(string)
+				if !slices.Contains(clusterValidBlueGreenEngines(), engine) {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine" is %q.`, engine)
+				}
+
+				if d.Get("global_cluster_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "global_cluster_identifier" is set.`)
+				}
+
+				if d.Get("replication_source_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "replication_source_identifier" is set.`)
+				}
+
+				if engineMode := d.Get("engine_mode").(string); engineMode != EngineModeProvisioned {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine_mode" is %q.`, engineMode)
+				}
+				return nil
+			},
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// Validate Blue/Green engine upgrades before any resources are created.
+				if d.Id() == "" || !d.Get("blue_green_update.0.enabled").(bool) || !d.HasChange("engine_version") || !d.NewValueKnown("engine_version") {
+					return nil
+				}
+
+				from, n := clusterUpgradeSourceVersion(d), d.Get("engine_version").(string)
+				if from == "" || n == "" {
+					return nil
+				}
+
+				// The cluster already runs the configured version.
+				if clusterEngineVersionMatches(from, n) {
+					return nil
+				}
+
+				handler := newClusterHandler(meta.(*conns.AWSClient).RDSClient(ctx))
+				_, err := handler.findUpgradeTarget(ctx, d.Get("engine").(string), from, n, d.Get("allow_major_version_upgrade").(bool))
+				return err
+			},
 			customdiff.ForceNewIf("storage_type", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
 				// Aurora supports mutation of the storage_type parameter, other engines do not
 				return !strings.HasPrefix(d.Get("engine").(string), "aurora")
@@ -1219,14 +1283,31 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
 	conn := meta.(*conns.AWSClient).RDSConn(ctx)
 
-	if d.HasChangesExcept(
+	// Engine version and parameter group changes are applied via a Blue/Green Deployment when enabled.
+	// Any other changes are then applied to the new (former Green) cluster as usual.
+	blueGreenUpdate := d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges("db_cluster_parameter_group_name", "db_instance_parameter_group_name", "engine_version")
+	if blueGreenUpdate {
+		diags = append(diags, clusterBlueGreenUpdate(ctx, d, meta)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	except := []string{
 		"allow_major_version_upgrade",
+		"blue_green_update",
 		"final_snapshot_identifier",
 		"global_cluster_identifier",
 		"iam_roles",
 		"replication_source_identifier",
 		"skip_final_snapshot",
-		"tags", "tags_all") {
+		"tags", "tags_all",
+	}
+	if blueGreenUpdate {
+		except = append(except, "db_cluster_parameter_group_name", "db_instance_parameter_group_name", "engine_version")
+	}
+
+	if d.HasChangesExcept(except...) {
 		input := &rds.ModifyDBClusterInput{
 			ApplyImmediately:    aws.Bool(d.Get("apply_immediately").(bool)),
 			DBClusterIdentifier: aws.String(d.Id()),
@@ -1256,7 +1337,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.DBClusterInstanceClass = aws.String(d.Get("db_cluster_instance_class").(string))
 		}
 
-		if d.HasChange("db_cluster_parameter_group_name") {
+		if d.HasChange("db_cluster_parameter_group_name") && !blueGreenUpdate {
 			input.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
 		}
 
@@ -1265,7 +1346,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		// set, the configured attribute should always be sent on modify.
 		// Except, this causes an error on a minor version upgrade, so it is
 		// removed during update retry, if necessary.
-		if v, ok := d.GetOk("db_instance_parameter_group_name"); ok || d.HasChange("db_instance_parameter_group_name") {
+		if v, ok := d.GetOk("db_instance_parameter_group_name"); (ok || d.HasChange("db_instance_parameter_group_name")) && !blueGreenUpdate {
 			input.DBInstanceParameterGroupName = aws.String(v.(string))
 		}
 
@@ -1292,14 +1373,14 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			}
 		}
 
-		if d.HasChange("engine_version") {
+		if d.HasChange("engine_version") && !blueGreenUpdate {
 			input.EngineVersion = aws.String(d.Get("engine_version").(string))
 		}
 
 		// This can happen when updates are deferred (apply_immediately = false), and
 		// multiple applies occur before the maintenance window. In this case,
 		// continue sending the desired engine_version as part of the modify request.
-		if d.Get("engine_version").(string) != d.Get("engine_version_actual").(string) {
+		if d.Get("engine_version").(string) != d.Get("engine_version_actual").(string) && !blueGreenUpdate {
 			input.EngineVersion = aws.String(d.Get("engine_version").(string))
 		}
 
@@ -1559,6 +1640,164 @@ func resourceClusterImport(_ context.Context, d *schema.ResourceData, meta inter
 	return []*schema.ResourceData{d}, nil
 }
 
+// clusterBlueGreenUpdate applies engine version and parameter group changes to an Aurora cluster
+// by creating a Blue/Green Deployment, switching over to the Green environment and deleting the
+// former Blue environment.
+func clusterBlueGreenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) (diags diag.Diagnostics) {
+	conn := meta.(*conns.AWSClient).RDSConn(ctx)
+	orchestrator := newBlueGreenOrchestrator(meta.(*conns.AWSClient).RDSClient(ctx))
+	handler := newClusterHandler(meta.(*conns.AWSClient).RDSClient(ctx))
+	deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutUpdate))
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Creating Blue/Green Deployment", d.Id())
+
+	input, err := handler.createBlueGreenInput(ctx, d)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+
+	dep, err := orchestrator.createDeployment(ctx, input)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+
+	deploymentIdentifier := aws.StringValue(dep.BlueGreenDeploymentIdentifier)
+	var deploymentDeleted, switchedOver bool
+	defer func() {
+		if deploymentDeleted {
+			return
+		}
+
+		// Ensure that the Blue/Green Deployment, and the Green environment if not switched over, is always cleaned up.
+		log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment", d.Id())
+		if err := orchestrator.deleteDeployment(ctx, deploymentIdentifier, !switchedOver, deadline.Remaining()); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+		}
+	}()
+
+	dep, err = orchestrator.waitForDeploymentAvailable(ctx, deploymentIdentifier, deadline.Remaining())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Switching over Blue/Green Deployment", d.Id())
+
+	dep, err = orchestrator.switchover(ctx, aws.StringValue(dep.BlueGreenDeploymentIdentifier), handler.switchoverTimeout(d), deadline.Remaining())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+	switchedOver = true
+
+	if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), deadline.Remaining()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): switching over Blue/Green Deployment: waiting for cluster: %s", d.Id(), err)
+	}
+
+	// After switchover the former Blue environment has been renamed.
+	sourceARN, err := parseDBClusterARN(aws.StringValue(dep.Source))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+	}
+	if sourceARN.Identifier == d.Id() {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: source has not been renamed", d.Id())
+	}
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment", d.Id())
+
+	if err := orchestrator.deleteDeployment(ctx, deploymentIdentifier, false, deadline.Remaining()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+	deploymentDeleted = true
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment source (%s)", d.Id(), sourceARN.Identifier)
+
+	if err := deleteClusterAndInstances(ctx, conn, sourceARN.Identifier, deadline.Remaining()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// deleteClusterAndInstances deletes a cluster, without a final snapshot, along with its member DB instances.
+func deleteClusterAndInstances(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) error {
+	cluster, err := FindDBClusterByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading RDS Cluster (%s): %w", id, err)
+	}
+
+	var instanceIDs []string
+	for _, member := range cluster.DBClusterMembers {
+		instanceID := aws.StringValue(member.DBInstanceIdentifier)
+
+		log.Printf("[DEBUG] Deleting RDS DB Instance: %s", instanceID)
+		_, err := conn.DeleteDBInstanceWithContext(ctx, &rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(instanceID),
+			SkipFinalSnapshot:    aws.Bool(true),
+		})
+
+		if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting RDS DB Instance (%s): %w", instanceID, err)
+		}
+
+		instanceIDs = append(instanceIDs, instanceID)
+	}
+
+	for _, instanceID := range instanceIDs {
+		if _, err := waitDBInstanceDeleted(ctx, conn, instanceID, timeout); err != nil {
+			return fmt.Errorf("waiting for RDS DB Instance (%s) delete: %w", instanceID, err)
+		}
+	}
+
+	if aws.BoolValue(cluster.DeletionProtection) {
+		_, err := conn.ModifyDBClusterWithContext(ctx, &rds.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(id),
+			DeletionProtection:  aws.Bool(false),
+		})
+
+		if err != nil {
+			return fmt.Errorf("modifying RDS Cluster (%s) DeletionProtection=false: %w", id, err)
+		}
+
+		if _, err := waitDBClusterUpdated(ctx, conn, id, timeout); err != nil {
+			return fmt.Errorf("waiting for RDS Cluster (%s) update: %w", id, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Cluster: %s", id)
+	_, err = tfresource.RetryWhenAWSErrMessageContains(ctx, clusterTimeoutDelete,
+		func() (interface{}, error) {
+			return conn.DeleteDBClusterWithContext(ctx, &rds.DeleteDBClusterInput{
+				DBClusterIdentifier: aws.String(id),
+				SkipFinalSnapshot:   aws.Bool(true),
+			})
+		},
+		rds.ErrCodeInvalidDBClusterStateFault, "is not currently in the available state",
+	)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting RDS Cluster (%s): %w", id, err)
+	}
+
+	if _, err := waitDBClusterDeleted(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for RDS Cluster (%s) delete: %w", id, err)
+	}
+
+	return nil
+}
+
 func addIAMRoleToCluster(ctx context.Context, conn *rds.RDS, clusterID, roleARN string) error {
 	input := &rds.AddRoleToDBClusterInput{
 		DBClusterIdentifier: aws.String(clusterID),
@@ -1597,6 +1836,38 @@ func clusterSetResourceDataEngineVersionFromCluster(d *schema.ResourceData, c *r
 	compareActualEngineVersion(d, oldVersion, newVersion, pendingVersion)
 }
 
+func clusterValidBlueGreenEngines() []string {
+	return []string{
+		ClusterEngineAuroraMySQL,
+		ClusterEngineAuroraPostgreSQL,
+	}
+}
+
+type dbClusterARN struct {
+	arn.ARN
+	Identifier string
+}
+
+func parseDBClusterARN(s string) (dbClusterARN, error) {
+	arn, err := arn.Parse(s)
+	if err != nil {
+		return dbClusterARN{}, err
+	}
+
+	result := dbClusterARN{
+		ARN: arn,
+	}
+
+	re := regexache.MustCompile(`^cluster:([0-9a-z-]+)$`)
+	matches := re.FindStringSubmatch(arn.Resource)
+	if matches == nil || len(matches) != 2 {
+		return dbClusterARN{}, errors.New("DB Cluster ARN: invalid resource section")
+	}
+	result.Identifier = matches[1]
+
+	return result, nil
+}
+
 func FindDBClusterByID(ctx context.Context, conn *rds.RDS, id string) (*rds.DBCluster, error) {
 	input := &rds.DescribeDBClustersInput{
 		DBClusterIdentifier: aws.String(id),
@@ -5,9 +5,15 @@ package route53
 
 // Exports for use in tests only.
 var (
-	CIDRLocationParseResourceID  = cidrLocationParseResourceID
-	FindCIDRCollectionByID       = findCIDRCollectionByID
-	FindCIDRLocationByTwoPartKey = findCIDRLocationByTwoPartKey
-	ResourceCIDRCollection       = newResourceCIDRCollection
-	ResourceCIDRLocation         = newResourceCIDRLocation
+	CIDRLocationParseResourceID    = cidrLocationParseResourceID
+	ChunkChanges                   = chunkChanges
+	FindCIDRCollectionByID         = findCIDRCollectionByID
+	FindCIDRLocationByTwoPartKey   = findCIDRLocationByTwoPartKey
+	FindResourceRecordSetsByZoneID = findResourceRecordSetsByZoneID
+	ParseZoneFile                  = parseZoneFile
+	RecordsCreateResourceID        = recordsCreateResourceID
+	RecordsParseImportID           = recordsParseImportID
+	RenderZoneFile                 = renderZoneFile
+	ResourceCIDRCollection         = newResourceCIDRCollection
+	ResourceCIDRLocation           = newResourceCIDRLocation
 )
@@ -0,0 +1,925 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// ChangeResourceRecordSets quotas.
+	// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests-changeresourcerecordsets.
+	changeBatchMaxResourceRecords = 1000
+	changeBatchMaxValueLength     = 32000
+
+	recordsIDSeparator  = ","
+	recordsIDHashLength = 16
+)
+
+// @SDKResource("aws_route53_records", name="Records")
+func ResourceRecords() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRecordsCreate,
+		ReadWithoutTimeout:   resourceRecordsRead,
+		UpdateWithoutTimeout: resourceRecordsUpdate,
+		DeleteWithoutTimeout: resourceRecordsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRecordsImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_overwrite": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"name_regex": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"record": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Set:      recordsRecordHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"evaluate_target_health": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+									"zone_id": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 32),
+									},
+								},
+							},
+						},
+						"cidr_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"collection_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"location_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"failover_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(route53.ResourceRecordSetFailover_Values(), false),
+									},
+								},
+							},
+						},
+						"geolocation_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"continent": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"country": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"subdivision": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"health_check_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"latency_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"region": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"multivalue_answer_routing_policy": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"records": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"set_identifier": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(route53.RRType_Values(), false),
+						},
+						"weighted_routing_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"weight": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"record_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"zone_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	zone, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", zoneID, err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	action := route53.ChangeActionCreate
+	if d.Get("allow_overwrite").(bool) {
+		action = route53.ChangeActionUpsert
+	}
+
+	tfList := d.Get("record").(*schema.Set).List()
+	var changes []*route53.Change
+	for _, tfMapRaw := range tfList {
+		apiObject, err := expandRecordsResourceRecordSet(tfMapRaw.(map[string]interface{}), zoneName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Route 53 Records: %s", err)
+		}
+
+		changes = append(changes, &route53.Change{
+			Action:            aws.String(action),
+			ResourceRecordSet: apiObject,
+		})
+	}
+
+	// The ID is set before any change batch is submitted so that, if a later batch fails, the records
+	// of the batches already submitted are tracked in state and removed on the next apply.
+	var recordSets []*route53.ResourceRecordSet
+	for _, v := range changes {
+		recordSets = append(recordSets, v.ResourceRecordSet)
+	}
+	d.SetId(recordsCreateResourceID(zoneID, zoneName, recordSets))
+	d.Set("name_regex", "")
+	d.Set("record_type", "")
+
+	if n, err := changeResourceRecordSetsInBatches(ctx, conn, zoneID, "Managed by Terraform", changes, d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "creating Route 53 Records (%s): %s", zoneID, err)
+
+		if n == 0 {
+			d.SetId("")
+			return diags
+		}
+
+		// Only track the records that this resource created or overwrote.
+		if err := d.Set("record", tfList[:n]); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting record: %s", err)
+		}
+
+		return append(diags, resourceRecordsRead(ctx, d, meta)...)
+	}
+
+	return append(diags, resourceRecordsRead(ctx, d, meta)...)
+}
+
+func resourceRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	zone, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Route 53 Records (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	recordSets, err := findResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	byKey := make(map[string]*route53.ResourceRecordSet, len(recordSets))
+	for _, v := range recordSets {
+		byKey[resourceRecordSetKey(aws.StringValue(v.Name), aws.StringValue(v.Type), aws.StringValue(v.SetIdentifier), zoneName)] = v
+	}
+
+	var tfList []interface{}
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		key := resourceRecordSetKey(tfMap["name"].(string), tfMap["type"].(string), tfMap["set_identifier"].(string), zoneName)
+
+		apiObject, ok := byKey[key]
+		if !ok {
+			log.Printf("[WARN] Route 53 Records (%s) record (%s) not found, removing from state", d.Id(), key)
+			continue
+		}
+
+		v := flattenResourceRecordSet(apiObject)
+		// Retain the configured form of the record name, which may be relative to the zone.
+		v["name"] = tfMap["name"]
+		tfList = append(tfList, v)
+	}
+
+	if err := d.Set("record", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting record: %s", err)
+	}
+	d.Set("zone_id", zoneID)
+
+	return diags
+}
+
+func resourceRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	if d.HasChange("record") {
+		zoneID := CleanZoneID(d.Get("zone_id").(string))
+		zone, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", zoneID, err)
+		}
+
+		zoneName := aws.StringValue(zone.HostedZone.Name)
+		recordSets, err := findResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+		}
+
+		liveByKey := make(map[string]*route53.ResourceRecordSet, len(recordSets))
+		for _, v := range recordSets {
+			liveByKey[resourceRecordSetKey(aws.StringValue(v.Name), aws.StringValue(v.Type), aws.StringValue(v.SetIdentifier), zoneName)] = v
+		}
+
+		o, n := d.GetChange("record")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		oldByKey := make(map[string]map[string]interface{})
+		for _, tfMapRaw := range os.List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			oldByKey[resourceRecordSetKey(tfMap["name"].(string), tfMap["type"].(string), tfMap["set_identifier"].(string), zoneName)] = tfMap
+		}
+
+		newByKey := make(map[string]map[string]interface{})
+		for _, tfMapRaw := range ns.List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+			newByKey[resourceRecordSetKey(tfMap["name"].(string), tfMap["type"].(string), tfMap["set_identifier"].(string), zoneName)] = tfMap
+		}
+
+		// Deletions are ordered before creations so that, for example, a CNAME can replace an A record with the same name.
+		var deletions, upserts []*route53.Change
+		for key := range oldByKey {
+			if _, ok := newByKey[key]; ok {
+				continue
+			}
+
+			// Delete the current value of the record as Route 53 requires an exact match.
+			apiObject, ok := liveByKey[key]
+			if !ok {
+				continue
+			}
+
+			deletions = append(deletions, &route53.Change{
+				Action:            aws.String(route53.ChangeActionDelete),
+				ResourceRecordSet: apiObject,
+			})
+		}
+
+		for key, tfMap := range newByKey {
+			action := route53.ChangeActionUpsert
+			if old, ok := oldByKey[key]; ok {
+				if recordsRecordHash(old) == recordsRecordHash(tfMap) {
+					continue
+				}
+			} else if !d.Get("allow_overwrite").(bool) {
+				action = route53.ChangeActionCreate
+			}
+
+			apiObject, err := expandRecordsResourceRecordSet(tfMap, zoneName)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Route 53 Records (%s): %s", d.Id(), err)
+			}
+
+			upserts = append(upserts, &route53.Change{
+				Action:            aws.String(action),
+				ResourceRecordSet: apiObject,
+			})
+		}
+
+		sortChanges(deletions)
+		sortChanges(upserts)
+
+		if _, err := changeResourceRecordSetsInBatches(ctx, conn, zoneID, "Managed by Terraform", append(deletions, upserts...), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Route 53 Records (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRecordsRead(ctx, d, meta)...)
+}
+
+func resourceRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneID := CleanZoneID(d.Get("zone_id").(string))
+	zone, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Hosted Zone (%s): %s", zoneID, err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	recordSets, err := findResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	keys := make(map[string]struct{})
+	for _, tfMapRaw := range d.Get("record").(*schema.Set).List() {
+		tfMap := tfMapRaw.(map[string]interface{})
+		keys[resourceRecordSetKey(tfMap["name"].(string), tfMap["type"].(string), tfMap["set_identifier"].(string), zoneName)] = struct{}{}
+	}
+
+	// Delete the current values of each record as Route 53 requires an exact match.
+	var changes []*route53.Change
+	for _, v := range recordSets {
+		if _, ok := keys[resourceRecordSetKey(aws.StringValue(v.Name), aws.StringValue(v.Type), aws.StringValue(v.SetIdentifier), zoneName)]; !ok {
+			continue
+		}
+
+		changes = append(changes, &route53.Change{
+			Action:            aws.String(route53.ChangeActionDelete),
+			ResourceRecordSet: v,
+		})
+	}
+
+	log.Printf("[DEBUG] Deleting Route 53 Records (%s): %d record sets", d.Id(), len(changes))
+	if _, err := changeResourceRecordSetsInBatches(ctx, conn, zoneID, "Deleted by Terraform", changes, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Route 53 Records (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceRecordsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).Route53Conn(ctx)
+
+	zoneID, nameRegex, recordType, err := recordsParseImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	zone, err := FindHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Route 53 Hosted Zone (%s): %w", zoneID, err)
+	}
+
+	zoneName := aws.StringValue(zone.HostedZone.Name)
+	recordSets, err := findResourceRecordSetsByZoneID(ctx, conn, zoneID)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Route 53 Records (%s): %w", zoneID, err)
+	}
+
+	recordSets = filterResourceRecordSets(recordSets, zoneName, nameRegex, recordType, false)
+
+	if len(recordSets) == 0 {
+		return nil, fmt.Errorf("no Route 53 Records in Hosted Zone (%s) match import ID (%s)", zoneID, d.Id())
+	}
+
+	var tfList []interface{}
+	for _, v := range recordSets {
+		tfList = append(tfList, flattenResourceRecordSet(v))
+	}
+
+	d.SetId(recordsCreateResourceID(zoneID, zoneName, recordSets))
+	d.Set("allow_overwrite", false)
+	d.Set("name_regex", nameRegex)
+	if err := d.Set("record", tfList); err != nil {
+		return nil, fmt.Errorf("setting record: %w", err)
+	}
+	d.Set("record_type", recordType)
+	d.Set("zone_id", zoneID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// recordsParseImportID parses an import ID of the form ZONEID[,NAME_REGEX[,TYPE]].
+// The zone ID is taken from the front and the record type from the end so that the name
+// regular expression may itself contain commas, e.g. "Z123,^a{1,3}\\.,A".
+// A final element consisting only of letters is always treated as the record type, so a
+// regular expression ending in such an element must be followed by an empty record type.
+func recordsParseImportID(id string) (string, string, string, error) {
+	zoneID, rest, hasRest := strings.Cut(id, recordsIDSeparator)
+
+	if zoneID == "" {
+		return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ZONEID[%[2]sNAME_REGEX[%[2]sTYPE]]", id, recordsIDSeparator)
+	}
+
+	zoneID, nameRegex, recordType := CleanZoneID(zoneID), rest, ""
+	if i := strings.LastIndex(rest, recordsIDSeparator); hasRest && i >= 0 && recordsImportIDTypeRegexp.MatchString(rest[i+len(recordsIDSeparator):]) {
+		nameRegex, recordType = rest[:i], strings.ToUpper(rest[i+len(recordsIDSeparator):])
+	}
+
+	if _, err := regexp.Compile(nameRegex); err != nil {
+		return "", "", "", fmt.Errorf("invalid name regular expression (%s) in ID (%s): %w", nameRegex, id, err)
+	}
+	if recordType != "" && !validRecordType(recordType) {
+		return "", "", "", fmt.Errorf("invalid record type (%s) in ID (%s)", recordType, id)
+	}
+
+	return zoneID, nameRegex, recordType, nil
+}
+
+var recordsImportIDTypeRegexp = regexp.MustCompile(`^[A-Za-z]*$`)
+
+// recordsCreateResourceID returns the resource ID, which is the hosted zone ID followed by a hash of the
+// keys of the record sets that the resource initially manages. The ID is computed once, on create or
+// import, so it stays the same as records are added or removed. Resources created from, or importing,
+// the same record sets of a zone have the same ID.
+func recordsCreateResourceID(zoneID, zoneName string, recordSets []*route53.ResourceRecordSet) string {
+	var keys []string
+	for _, v := range recordSets {
+		keys = append(keys, resourceRecordSetKey(aws.StringValue(v.Name), aws.StringValue(v.Type), aws.StringValue(v.SetIdentifier), zoneName))
+	}
+	sort.Strings(keys)
+
+	hash := sha256.Sum256([]byte(strings.Join(keys, "\n")))
+
+	return zoneID + recordsIDSeparator + hex.EncodeToString(hash[:])[:recordsIDHashLength]
+}
+
+// filterResourceRecordSets returns the record sets whose name matches nameRegex and whose type equals recordType.
+// Empty filter values match all record sets.
+// The zone apex SOA and NS record sets, which are managed by Route 53, are only returned if includeApexNSAndSOA is true.
+func filterResourceRecordSets(recordSets []*route53.ResourceRecordSet, zoneName, nameRegex, recordType string, includeApexNSAndSOA bool) []*route53.ResourceRecordSet {
+	var output []*route53.ResourceRecordSet
+
+	for _, v := range recordSets {
+		name := NormalizeAliasName(CleanRecordName(aws.StringValue(v.Name)))
+		typ := aws.StringValue(v.Type)
+
+		if !includeApexNSAndSOA && name == NormalizeAliasName(zoneName) && (typ == route53.RRTypeNs || typ == route53.RRTypeSoa) {
+			continue
+		}
+
+		if nameRegex != "" && !regexache.MustCompile(nameRegex).MatchString(name) {
+			continue
+		}
+
+		if recordType != "" && typ != recordType {
+			continue
+		}
+
+		output = append(output, v)
+	}
+
+	return output
+}
+
+func findResourceRecordSetsByZoneID(ctx context.Context, conn *route53.Route53, zoneID string) ([]*route53.ResourceRecordSet, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+	var output []*route53.ResourceRecordSet
+
+	err := conn.ListResourceRecordSetsPagesWithContext(ctx, input, func(page *route53.ListResourceRecordSetsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ResourceRecordSets {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, route53.ErrCodeNoSuchHostedZone) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// changeResourceRecordSetsInBatches submits the changes in as few change batches as the ChangeResourceRecordSets quotas allow.
+// Each change batch is applied atomically and is waited on before the next is submitted.
+// The number of leading changes that were submitted is returned, even on error.
+func changeResourceRecordSetsInBatches(ctx context.Context, conn *route53.Route53, zoneID, comment string, changes []*route53.Change, timeout time.Duration) (int, error) {
+	var n int
+
+	for i, batch := range chunkChanges(changes) {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &route53.ChangeBatch{
+				Changes: batch,
+				Comment: aws.String(comment),
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		log.Printf("[DEBUG] Submitting Route 53 Hosted Zone (%s) change batch %d (%d changes)", zoneID, i+1, len(batch))
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+			return conn.ChangeResourceRecordSetsWithContext(ctx, input)
+		}, route53.ErrCodeNoSuchHostedZone, route53.ErrCodePriorRequestNotComplete, route53.ErrCodeThrottlingException)
+
+		if err != nil {
+			return n, fmt.Errorf("change batch %d: %w", i+1, err)
+		}
+
+		n += len(batch)
+
+		changeInfo := outputRaw.(*route53.ChangeResourceRecordSetsOutput).ChangeInfo
+		if changeInfo == nil {
+			continue
+		}
+
+		if _, err := waitChangeInfoStatusInsync(ctx, conn, CleanChangeID(aws.StringValue(changeInfo.Id))); err != nil {
+			return n, fmt.Errorf("waiting for change batch %d (%s) to become INSYNC: %w", i+1, aws.StringValue(changeInfo.Id), err)
+		}
+	}
+
+	return n, nil
+}
+
+// chunkChanges splits changes into batches that satisfy the ChangeResourceRecordSets quotas on
+// the number of ResourceRecord elements and the total length of their values. UPSERT changes count twice.
+func chunkChanges(changes []*route53.Change) [][]*route53.Change {
+	var batches [][]*route53.Change
+	var batch []*route53.Change
+	var nRecords, valueLength int
+
+	for _, change := range changes {
+		n, l := 1, 0
+		if v := change.ResourceRecordSet; v != nil && len(v.ResourceRecords) > 0 {
+			n = len(v.ResourceRecords)
+			for _, r := range v.ResourceRecords {
+				l += len(aws.StringValue(r.Value))
+			}
+		}
+		if aws.StringValue(change.Action) == route53.ChangeActionUpsert {
+			n, l = 2*n, 2*l
+		}
+
+		if len(batch) > 0 && (nRecords+n > changeBatchMaxResourceRecords || valueLength+l > changeBatchMaxValueLength) {
+			batches = append(batches, batch)
+			batch, nRecords, valueLength = nil, 0, 0
+		}
+
+		batch = append(batch, change)
+		nRecords += n
+		valueLength += l
+	}
+
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+func sortChanges(changes []*route53.Change) {
+	sort.Slice(changes, func(i, j int) bool {
+		a, b := changes[i].ResourceRecordSet, changes[j].ResourceRecordSet
+		if v, w := aws.StringValue(a.Name), aws.StringValue(b.Name); v != w {
+			return v < w
+		}
+		if v, w := aws.StringValue(a.Type), aws.StringValue(b.Type); v != w {
+			return v < w
+		}
+		return aws.StringValue(a.SetIdentifier) < aws.StringValue(b.SetIdentifier)
+	})
+}
+
+// resourceRecordSetKey returns the key that uniquely identifies a record set within a hosted zone.
+func resourceRecordSetKey(name, recordType, setIdentifier, zoneName string) string {
+	name = ExpandRecordName(strings.ToLower(CleanRecordName(name)), zoneName)
+
+	return strings.Join([]string{name, recordType, setIdentifier}, "_")
+}
+
+func recordsRecordHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	tfMap := v.(map[string]interface{})
+
+	buf.WriteString(fmt.Sprintf("%s-", NormalizeAliasName(tfMap["name"].(string))))
+	buf.WriteString(fmt.Sprintf("%s-", tfMap["type"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", tfMap["set_identifier"].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", tfMap["ttl"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", tfMap["health_check_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%t-", tfMap["multivalue_answer_routing_policy"].(bool)))
+
+	if v, ok := tfMap["records"].(*schema.Set); ok {
+		records := make([]string, 0, v.Len())
+		for _, v := range v.List() {
+			records = append(records, v.(string))
+		}
+		sort.Strings(records)
+		buf.WriteString(fmt.Sprintf("%s-", strings.Join(records, ",")))
+	}
+
+	if v, ok := tfMap["alias"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		buf.WriteString(fmt.Sprintf("alias:%s-%s-%t-", NormalizeAliasName(tfMap["name"].(string)), tfMap["zone_id"].(string), tfMap["evaluate_target_health"].(bool)))
+	}
+
+	if v, ok := tfMap["cidr_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		buf.WriteString(fmt.Sprintf("cidr:%s-%s-", tfMap["collection_id"].(string), tfMap["location_name"].(string)))
+	}
+
+	if v, ok := tfMap["failover_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		buf.WriteString(fmt.Sprintf("failover:%s-", tfMap["type"].(string)))
+	}
+
+	if v, ok := tfMap["geolocation_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		buf.WriteString(fmt.Sprintf("geolocation:%s-%s-%s-", tfMap["continent"].(string), tfMap["country"].(string), tfMap["subdivision"].(string)))
+	}
+
+	if v, ok := tfMap["latency_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		buf.WriteString(fmt.Sprintf("latency:%s-", tfMap["region"].(string)))
+	}
+
+	if v, ok := tfMap["weighted_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		buf.WriteString(fmt.Sprintf("weighted:%d-", tfMap["weight"].(int)))
+	}
+
+	return create.StringHashcode(buf.String())
+}
+
+func expandRecordsResourceRecordSet(tfMap map[string]interface{}, zoneName string) (*route53.ResourceRecordSet, error) {
+	recordType := tfMap["type"].(string)
+	apiObject := &route53.ResourceRecordSet{
+		Name: aws.String(ExpandRecordName(tfMap["name"].(string), zoneName)),
+		Type: aws.String(recordType),
+	}
+
+	var hasAlias, hasRecords bool
+
+	if v, ok := tfMap["alias"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.AliasTarget = &route53.AliasTarget{
+			DNSName:              aws.String(tfMap["name"].(string)),
+			EvaluateTargetHealth: aws.Bool(tfMap["evaluate_target_health"].(bool)),
+			HostedZoneId:         aws.String(tfMap["zone_id"].(string)),
+		}
+		hasAlias = true
+	}
+
+	if v, ok := tfMap["cidr_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.CidrRoutingConfig = &route53.CidrRoutingConfig{
+			CollectionId: aws.String(tfMap["collection_id"].(string)),
+			LocationName: aws.String(tfMap["location_name"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["failover_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Failover = aws.String(tfMap["type"].(string))
+	}
+
+	if v, ok := tfMap["geolocation_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.GeoLocation = &route53.GeoLocation{
+			ContinentCode:   nilString(tfMap["continent"].(string)),
+			CountryCode:     nilString(tfMap["country"].(string)),
+			SubdivisionCode: nilString(tfMap["subdivision"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["health_check_id"].(string); ok && v != "" {
+		apiObject.HealthCheckId = aws.String(v)
+	}
+
+	if v, ok := tfMap["latency_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Region = aws.String(tfMap["region"].(string))
+	}
+
+	if v, ok := tfMap["multivalue_answer_routing_policy"].(bool); ok && v {
+		apiObject.MultiValueAnswer = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["records"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ResourceRecords = expandResourceRecords(v.List(), recordType)
+		hasRecords = true
+	}
+
+	if v, ok := tfMap["set_identifier"].(string); ok && v != "" {
+		apiObject.SetIdentifier = aws.String(v)
+	}
+
+	if v, ok := tfMap["ttl"].(int); ok && v != 0 {
+		apiObject.TTL = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["weighted_routing_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Weight = aws.Int64(int64(tfMap["weight"].(int)))
+	}
+
+	name := aws.StringValue(apiObject.Name)
+	if hasAlias == hasRecords {
+		return nil, fmt.Errorf("record (%s %s): exactly one of alias or records must be specified", name, recordType)
+	}
+	if hasAlias && apiObject.TTL != nil {
+		return nil, fmt.Errorf("record (%s %s): ttl cannot be specified for an alias record", name, recordType)
+	}
+	if hasRecords && apiObject.TTL == nil {
+		return nil, fmt.Errorf("record (%s %s): ttl is required with records", name, recordType)
+	}
+
+	return apiObject, nil
+}
+
+func flattenResourceRecordSet(apiObject *route53.ResourceRecordSet) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	recordType := aws.StringValue(apiObject.Type)
+	tfMap := map[string]interface{}{
+		"health_check_id":                  aws.StringValue(apiObject.HealthCheckId),
+		"multivalue_answer_routing_policy": aws.BoolValue(apiObject.MultiValueAnswer),
+		"name":                             NormalizeAliasName(CleanRecordName(aws.StringValue(apiObject.Name))),
+		"records":                          FlattenResourceRecords(apiObject.ResourceRecords, recordType),
+		"set_identifier":                   aws.StringValue(apiObject.SetIdentifier),
+		"ttl":                              aws.Int64Value(apiObject.TTL),
+		"type":                             recordType,
+	}
+
+	if v := apiObject.AliasTarget; v != nil {
+		tfMap["alias"] = []interface{}{map[string]interface{}{
+			"evaluate_target_health": aws.BoolValue(v.EvaluateTargetHealth),
+			"name":                   NormalizeAliasName(aws.StringValue(v.DNSName)),
+			"zone_id":                aws.StringValue(v.HostedZoneId),
+		}}
+	}
+
+	if v := apiObject.CidrRoutingConfig; v != nil {
+		tfMap["cidr_routing_policy"] = []interface{}{map[string]interface{}{
+			"collection_id": aws.StringValue(v.CollectionId),
+			"location_name": aws.StringValue(v.LocationName),
+		}}
+	}
+
+	if v := apiObject.Failover; v != nil {
+		tfMap["failover_routing_policy"] = []interface{}{map[string]interface{}{
+			"type": aws.StringValue(v),
+		}}
+	}
+
+	if v := apiObject.GeoLocation; v != nil {
+		tfMap["geolocation_routing_policy"] = []interface{}{map[string]interface{}{
+			"continent":   aws.StringValue(v.ContinentCode),
+			"country":     aws.StringValue(v.CountryCode),
+			"subdivision": aws.StringValue(v.SubdivisionCode),
+		}}
+	}
+
+	if v := apiObject.Region; v != nil {
+		tfMap["latency_routing_policy"] = []interface{}{map[string]interface{}{
+			"region": aws.StringValue(v),
+		}}
+	}
+
+	if v := apiObject.Weight; v != nil {
+		tfMap["weighted_routing_policy"] = []interface{}{map[string]interface{}{
+			"weight": aws.Int64Value(v),
+		}}
+	}
+
+	return tfMap
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53RecordsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// 3 managed records plus the zone apex NS and SOA records.
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "zone_file", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53RecordsDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsDataSourceConfig_filter(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.name", "www."+zoneName.String()),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.type", "A"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.ttl", "300"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.records.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.alias.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "zone_file", fmt.Sprintf("$ORIGIN %[1]s.\nwww.%[1]s.\t300\tIN\tA\t127.0.0.1\nwww.%[1]s.\t300\tIN\tA\t127.0.0.27\n", zoneName.String())),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecordsDataSourceConfig_basic(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsConfig_basic(zoneName), `
+data "aws_route53_records" "test" {
+  zone_id = aws_route53_records.test.zone_id
+}
+`)
+}
+
+func testAccRecordsDataSourceConfig_filter(zoneName string) string {
+	return acctest.ConfigCompose(testAccRecordsConfig_basic(zoneName), `
+data "aws_route53_records" "test" {
+  zone_id          = aws_route53_records.test.zone_id
+  name_regex       = "^www\\."
+  type             = "A"
+  render_zone_file = true
+}
+`)
+}
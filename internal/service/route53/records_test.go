@@ -0,0 +1,644 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestChunkChanges(t *testing.T) {
+	t.Parallel()
+
+	change := func(action string, values ...string) *route53.Change {
+		apiObject := &route53.ResourceRecordSet{
+			Name: aws.String("www.example.com"),
+			Type: aws.String(route53.RRTypeTxt),
+		}
+		for _, v := range values {
+			apiObject.ResourceRecords = append(apiObject.ResourceRecords, &route53.ResourceRecord{Value: aws.String(v)})
+		}
+
+		return &route53.Change{
+			Action:            aws.String(action),
+			ResourceRecordSet: apiObject,
+		}
+	}
+
+	repeat := func(n int, c *route53.Change) []*route53.Change {
+		var output []*route53.Change
+		for i := 0; i < n; i++ {
+			output = append(output, c)
+		}
+		return output
+	}
+
+	testCases := []struct {
+		name    string
+		changes []*route53.Change
+		want    []int
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:    "single batch",
+			changes: repeat(1000, change(route53.ChangeActionCreate, "a")),
+			want:    []int{1000},
+		},
+		{
+			name:    "record count",
+			changes: repeat(1001, change(route53.ChangeActionCreate, "a")),
+			want:    []int{1000, 1},
+		},
+		{
+			name:    "upsert counts twice",
+			changes: repeat(501, change(route53.ChangeActionUpsert, "a")),
+			want:    []int{500, 1},
+		},
+		{
+			name:    "multiple records",
+			changes: repeat(3, change(route53.ChangeActionDelete, strings.Split(strings.Repeat("a", 400), "")...)),
+			want:    []int{2, 1},
+		},
+		{
+			name:    "value length",
+			changes: repeat(5, change(route53.ChangeActionCreate, strings.Repeat("a", 10000))),
+			want:    []int{3, 2},
+		},
+		{
+			name:    "alias",
+			changes: repeat(1500, &route53.Change{Action: aws.String(route53.ChangeActionCreate), ResourceRecordSet: &route53.ResourceRecordSet{AliasTarget: &route53.AliasTarget{}}}),
+			want:    []int{1000, 500},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []int
+			for _, v := range tfroute53.ChunkChanges(testCase.changes) {
+				got = append(got, len(v))
+			}
+
+			if fmt.Sprint(got) != fmt.Sprint(testCase.want) {
+				t.Errorf("got batch sizes %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestRecordsParseImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		id                            string
+		zoneID, nameRegex, recordType string
+		expectError                   bool
+	}{
+		{id: "", expectError: true},
+		{id: ",www", expectError: true},
+		{id: "Z123", zoneID: "Z123"},
+		{id: "/hostedzone/Z123", zoneID: "Z123"},
+		{id: "Z123,^www\\.", zoneID: "Z123", nameRegex: "^www\\."},
+		{id: "Z123,(", expectError: true},
+		{id: "Z123,,txt", zoneID: "Z123", recordType: "TXT"},
+		{id: "Z123,.*,A", zoneID: "Z123", nameRegex: ".*", recordType: "A"},
+		{id: "Z123,.*,BOGUS", expectError: true},
+		{id: "Z123,^a{1,3}\\.", zoneID: "Z123", nameRegex: "^a{1,3}\\."},
+		{id: "Z123,^a{1,3}\\.,txt", zoneID: "Z123", nameRegex: "^a{1,3}\\.", recordType: "TXT"},
+		{id: "Z123,^(a|b),c,", zoneID: "Z123", nameRegex: "^(a|b),c"},
+	}
+
+	for _, testCase := range testCases {
+		zoneID, nameRegex, recordType, err := tfroute53.RecordsParseImportID(testCase.id)
+
+		if testCase.expectError {
+			if err == nil {
+				t.Errorf("%q: expected error", testCase.id)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", testCase.id, err)
+			continue
+		}
+
+		if zoneID != testCase.zoneID || nameRegex != testCase.nameRegex || recordType != testCase.recordType {
+			t.Errorf("%q: got (%q, %q, %q), want (%q, %q, %q)", testCase.id, zoneID, nameRegex, recordType, testCase.zoneID, testCase.nameRegex, testCase.recordType)
+		}
+	}
+}
+
+func TestRecordsCreateResourceID(t *testing.T) {
+	t.Parallel()
+
+	recordSet := func(name, recordType string) *route53.ResourceRecordSet {
+		return &route53.ResourceRecordSet{
+			Name: aws.String(name),
+			Type: aws.String(recordType),
+		}
+	}
+
+	zoneName := "example.com"
+	want := tfroute53.RecordsCreateResourceID("Z123", zoneName, []*route53.ResourceRecordSet{
+		recordSet("www.example.com", route53.RRTypeA),
+		recordSet("txt.example.com", route53.RRTypeTxt),
+	})
+
+	if got, wantPrefix := want, "Z123,"; !strings.HasPrefix(got, wantPrefix) || len(got) != len(wantPrefix)+16 {
+		t.Errorf("got %q, want %q followed by a 16 character hash", got, wantPrefix)
+	}
+
+	testCases := []struct {
+		name       string
+		zoneID     string
+		recordSets []*route53.ResourceRecordSet
+		wantEqual  bool
+	}{
+		{
+			name:   "same records in different order and form",
+			zoneID: "Z123",
+			recordSets: []*route53.ResourceRecordSet{
+				recordSet("TXT", route53.RRTypeTxt),
+				recordSet("www.example.com.", route53.RRTypeA),
+			},
+			wantEqual: true,
+		},
+		{
+			name:   "different zone",
+			zoneID: "Z456",
+			recordSets: []*route53.ResourceRecordSet{
+				recordSet("www.example.com", route53.RRTypeA),
+				recordSet("txt.example.com", route53.RRTypeTxt),
+			},
+		},
+		{
+			name:   "different records",
+			zoneID: "Z123",
+			recordSets: []*route53.ResourceRecordSet{
+				recordSet("www.example.com", route53.RRTypeA),
+			},
+		},
+		{
+			name:   "different type",
+			zoneID: "Z123",
+			recordSets: []*route53.ResourceRecordSet{
+				recordSet("www.example.com", route53.RRTypeAaaa),
+				recordSet("txt.example.com", route53.RRTypeTxt),
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		got := tfroute53.RecordsCreateResourceID(testCase.zoneID, zoneName, testCase.recordSets)
+
+		if equal := got == want; equal != testCase.wantEqual {
+			t.Errorf("%s: got %q, want equal to %q: %t", testCase.name, got, want, testCase.wantEqual)
+		}
+	}
+}
+
+func TestAccRoute53Records_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "allow_overwrite", "false"),
+					resource.TestCheckResourceAttr(resourceName, "name_regex", ""),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "record_type", ""),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "www." + zoneName.String(),
+						"type":      "A",
+						"ttl":       "300",
+						"records.#": "2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "txt." + zoneName.String(),
+						"type":      "TXT",
+						"records.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":    "alias." + zoneName.String(),
+						"type":    "A",
+						"alias.#": "1",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "zone_id", "aws_route53_zone.test", "zone_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccRecordsImportStateIdFunc(resourceName, ""),
+				ImportStateCheck:  testAccCheckRecordsImportState("", "", 3),
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_multipleInZone(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource1Name := "aws_route53_records.test1"
+	resource2Name := "aws_route53_records.test2"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_multipleInZone(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resource1Name, 3),
+					resource.TestCheckResourceAttr(resource1Name, "record.#", "2"),
+					resource.TestCheckResourceAttr(resource2Name, "record.#", "1"),
+					testAccCheckRecordsDifferentIDs(resource1Name, resource2Name),
+				),
+			},
+			{
+				ResourceName:      resource1Name,
+				ImportState:       true,
+				ImportStateIdFunc: testAccRecordsImportStateIdFunc(resource1Name, `,^www\.,A`),
+				ImportStateCheck:  testAccCheckRecordsImportState(`^www\.`, "A", 1),
+			},
+			{
+				ResourceName:      resource2Name,
+				ImportState:       true,
+				ImportStateIdFunc: testAccRecordsImportStateIdFunc(resource2Name, ",,TXT"),
+				ImportStateCheck:  testAccCheckRecordsImportState("", "TXT", 1),
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String()),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName, 3),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfroute53.ResourceRecords(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName, 3),
+				),
+			},
+			{
+				Config: testAccRecordsConfig_updated(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "www",
+						"type":      "A",
+						"ttl":       "60",
+						"records.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "txt",
+						"type":      "CNAME",
+						"records.#": "1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record.*", map[string]string{
+						"name":      "mail",
+						"type":      "MX",
+						"records.#": "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53Records_many(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_records.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordsConfig_many(zoneName.String(), 1200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecordsExists(ctx, resourceName, 1200),
+					resource.TestCheckResourceAttr(resourceName, "record.#", "1200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecordsImportStateIdFunc(n, filter string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes["zone_id"] + filter, nil
+	}
+}
+
+func testAccCheckRecordsImportState(nameRegex, recordType string, count int) resource.ImportStateCheckFunc {
+	return func(is []*terraform.InstanceState) error {
+		if len(is) != 1 {
+			return fmt.Errorf("expected 1 imported resource, got %d", len(is))
+		}
+
+		rs := is[0]
+
+		if want := rs.Attributes["zone_id"] + ","; !strings.HasPrefix(rs.ID, want) {
+			return fmt.Errorf("Route 53 Records ID: got %s, want prefix %s", rs.ID, want)
+		}
+
+		if got := rs.Attributes["name_regex"]; got != nameRegex {
+			return fmt.Errorf("Route 53 Records %s name_regex: got %s, want %s", rs.ID, got, nameRegex)
+		}
+
+		if got := rs.Attributes["record_type"]; got != recordType {
+			return fmt.Errorf("Route 53 Records %s record_type: got %s, want %s", rs.ID, got, recordType)
+		}
+
+		if got, want := rs.Attributes["record.#"], strconv.Itoa(count); got != want {
+			return fmt.Errorf("Route 53 Records %s: got %s records, want %s", rs.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordsDifferentIDs(n1, n2 string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs1, ok := s.RootModule().Resources[n1]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n1)
+		}
+
+		rs2, ok := s.RootModule().Resources[n2]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n2)
+		}
+
+		if rs1.Primary.ID == rs2.Primary.ID {
+			return fmt.Errorf("Route 53 Records %s and %s have the same ID (%s)", n1, n2, rs1.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_route53_records" {
+				continue
+			}
+
+			output, err := tfroute53.FindResourceRecordSetsByZoneID(ctx, conn, rs.Primary.Attributes["zone_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			for _, v := range output {
+				if typ := aws.StringValue(v.Type); typ != route53.RRTypeNs && typ != route53.RRTypeSoa {
+					return fmt.Errorf("Route 53 Records %s still exist", rs.Primary.ID)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRecordsExists(ctx context.Context, n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Route 53 Records ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Conn(ctx)
+
+		output, err := tfroute53.FindResourceRecordSetsByZoneID(ctx, conn, rs.Primary.Attributes["zone_id"])
+
+		if err != nil {
+			return err
+		}
+
+		var n int
+		for _, v := range output {
+			if typ := aws.StringValue(v.Type); typ != route53.RRTypeNs && typ != route53.RRTypeSoa {
+				n++
+			}
+		}
+
+		if n != count {
+			return fmt.Errorf("Route 53 Records %s: got %d record sets, want %d", rs.Primary.ID, n, count)
+		}
+
+		return nil
+	}
+}
+
+func testAccRecordsConfig_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www.%[1]s"
+    type    = "A"
+    ttl     = 300
+    records = ["127.0.0.1", "127.0.0.27"]
+  }
+
+  record {
+    name    = "txt.%[1]s"
+    type    = "TXT"
+    ttl     = 300
+    records = ["v=spf1 -all"]
+  }
+
+  record {
+    name = "alias.%[1]s"
+    type = "A"
+
+    alias {
+      name                   = "www.%[1]s"
+      zone_id                = aws_route53_zone.test.zone_id
+      evaluate_target_health = false
+    }
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsConfig_updated(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = 60
+    records = ["127.0.0.1"]
+  }
+
+  record {
+    name    = "txt"
+    type    = "CNAME"
+    ttl     = 300
+    records = ["www.%[1]s"]
+  }
+
+  record {
+    name    = "mail"
+    type    = "MX"
+    ttl     = 300
+    records = ["10 mx1.%[1]s", "20 mx2.%[1]s"]
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsConfig_multipleInZone(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test1" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = 300
+    records = ["127.0.0.1"]
+  }
+
+  record {
+    name    = "www2"
+    type    = "A"
+    ttl     = 300
+    records = ["127.0.0.2"]
+  }
+}
+
+resource "aws_route53_records" "test2" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "txt"
+    type    = "TXT"
+    ttl     = 300
+    records = ["v=spf1 -all"]
+  }
+}
+`, zoneName)
+}
+
+func testAccRecordsConfig_many(zoneName string, count int) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  dynamic "record" {
+    for_each = range(%[2]d)
+
+    content {
+      name    = "record${record.value}.%[1]s"
+      type    = "A"
+      ttl     = 300
+      records = ["127.0.${floor(record.value / 256)}.${record.value %% 256}"]
+    }
+  }
+}
+`, zoneName, count)
+}
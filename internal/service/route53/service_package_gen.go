@@ -33,6 +33,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceDelegationSet,
 			TypeName: "aws_route53_delegation_set",
 		},
+		{
+			Factory:  DataSourceRecords,
+			TypeName: "aws_route53_records",
+		},
 		{
 			Factory:  DataSourceTrafficPolicyDocument,
 			TypeName: "aws_route53_traffic_policy_document",
@@ -41,6 +45,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceZone,
 			TypeName: "aws_route53_zone",
 		},
+		{
+			Factory:  DataSourceZoneFile,
+			TypeName: "aws_route53_zone_file",
+		},
 	}
 }
 
@@ -75,6 +83,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceRecord,
 			TypeName: "aws_route53_record",
 		},
+		{
+			Factory:  ResourceRecords,
+			TypeName: "aws_route53_records",
+			Name:     "Records",
+		},
 		{
 			Factory:  ResourceTrafficPolicy,
 			TypeName: "aws_route53_traffic_policy",
@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// renderZoneFile renders record sets in BIND zone file format.
+// Alias records and records with a routing policy have no zone file representation and are rendered as comments.
+func renderZoneFile(zoneName string, recordSets []*route53.ResourceRecordSet) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "$ORIGIN %s\n", FQDN(zoneName))
+
+	for _, v := range recordSets {
+		name := FQDN(CleanRecordName(aws.StringValue(v.Name)))
+		recordType := aws.StringValue(v.Type)
+
+		if v.AliasTarget != nil {
+			fmt.Fprintf(&b, "; %s ALIAS %s %s %s\n", name, recordType, FQDN(aws.StringValue(v.AliasTarget.DNSName)), aws.StringValue(v.AliasTarget.HostedZoneId))
+			continue
+		}
+
+		prefix := ""
+		if v.SetIdentifier != nil {
+			fmt.Fprintf(&b, "; set identifier %q\n", aws.StringValue(v.SetIdentifier))
+			prefix = "; "
+		}
+
+		for _, r := range v.ResourceRecords {
+			fmt.Fprintf(&b, "%s%s\t%d\tIN\t%s\t%s\n", prefix, name, aws.Int64Value(v.TTL), recordType, aws.StringValue(r.Value))
+		}
+	}
+
+	return b.String()
+}
+
+// zoneFileTTLUnits are the BIND TTL unit suffixes, in seconds.
+var zoneFileTTLUnits = map[byte]int64{
+	's': 1,
+	'm': 60,
+	'h': 60 * 60,
+	'd': 24 * 60 * 60,
+	'w': 7 * 24 * 60 * 60,
+}
+
+// zoneFileLine is a logical line of a zone file, with parentheses and comments removed.
+type zoneFileLine struct {
+	number     int
+	tokens     []string
+	blankOwner bool
+}
+
+// parseZoneFile parses a BIND zone file into Route 53 record sets.
+// Relative names are qualified with origin, which may be overridden by $ORIGIN directives.
+// Record sets are returned in the order in which they first appear.
+// SOA records, which Route 53 manages, are skipped, as are the zone apex NS records unless includeApexNS is true.
+func parseZoneFile(content, origin string, defaultTTL int64, includeApexNS bool) ([]*route53.ResourceRecordSet, error) {
+	lines, err := tokenizeZoneFile(content)
+
+	if err != nil {
+		return nil, err
+	}
+
+	zoneName := strings.ToLower(FQDN(origin))
+	origin = zoneName
+
+	var recordSets []*route53.ResourceRecordSet
+	byKey := make(map[string]*route53.ResourceRecordSet)
+	typesByName := make(map[string][]string)
+	var owner string
+	var lastTTL int64 = -1
+
+	for _, line := range lines {
+		tokens := line.tokens
+
+		switch directive := strings.ToUpper(tokens[0]); directive {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN requires a single domain name", line.number)
+			}
+			origin = strings.ToLower(zoneFileQualifyName(tokens[1], origin))
+			continue
+		case "$TTL":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $TTL requires a single TTL", line.number)
+			}
+			ttl, err := parseZoneFileTTL(tokens[1])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line.number, err)
+			}
+			defaultTTL = ttl
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, fmt.Errorf("line %d: %s directive is not supported", line.number, directive)
+		}
+
+		if !line.blankOwner {
+			owner = strings.ToLower(zoneFileQualifyName(tokens[0], origin))
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", line.number)
+		}
+
+		if owner != zoneName && !strings.HasSuffix(owner, "."+zoneName) {
+			return nil, fmt.Errorf("line %d: name (%s) is outside of zone (%s)", line.number, owner, zoneName)
+		}
+
+		// The TTL and class may appear in either order before the type.
+		ttl := int64(-1)
+		for i := 0; i < 2 && len(tokens) > 0; i++ {
+			if v := strings.ToUpper(tokens[0]); v == "IN" {
+				tokens = tokens[1:]
+			} else if v == "CH" || v == "HS" || v == "CS" {
+				return nil, fmt.Errorf("line %d: class %s is not supported", line.number, v)
+			} else if v, err := parseZoneFileTTL(tokens[0]); err == nil {
+				ttl = v
+				tokens = tokens[1:]
+			}
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: record has no type", line.number)
+		}
+
+		recordType := strings.ToUpper(tokens[0])
+		rdata := tokens[1:]
+
+		if !validRecordType(recordType) {
+			return nil, fmt.Errorf("line %d: record type (%s) is not supported by Route 53", line.number, recordType)
+		}
+
+		if len(rdata) == 0 {
+			return nil, fmt.Errorf("line %d: %s record has no data", line.number, recordType)
+		}
+
+		if ttl == -1 {
+			switch {
+			case defaultTTL >= 0:
+				ttl = defaultTTL
+			case lastTTL >= 0:
+				ttl = lastTTL
+			default:
+				return nil, fmt.Errorf("line %d: record has no TTL and no default TTL is set", line.number)
+			}
+		}
+		lastTTL = ttl
+
+		if recordType == route53.RRTypeSoa || (recordType == route53.RRTypeNs && owner == zoneName && !includeApexNS) {
+			continue
+		}
+
+		value, err := zoneFileRecordValue(recordType, rdata, origin)
+
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.number, err)
+		}
+
+		key := owner + "_" + recordType
+		recordSet, ok := byKey[key]
+		if !ok {
+			recordSet = &route53.ResourceRecordSet{
+				Name: aws.String(strings.TrimSuffix(owner, ".")),
+				TTL:  aws.Int64(ttl),
+				Type: aws.String(recordType),
+			}
+			byKey[key] = recordSet
+			recordSets = append(recordSets, recordSet)
+			typesByName[owner] = append(typesByName[owner], recordType)
+		}
+
+		// Route 53 requires a single TTL per record set.
+		if ttl < aws.Int64Value(recordSet.TTL) {
+			recordSet.TTL = aws.Int64(ttl)
+		}
+
+		duplicate := false
+		for _, v := range recordSet.ResourceRecords {
+			if aws.StringValue(v.Value) == value {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			recordSet.ResourceRecords = append(recordSet.ResourceRecords, &route53.ResourceRecord{Value: aws.String(value)})
+		}
+	}
+
+	for name, types := range typesByName {
+		for _, v := range types {
+			if v != route53.RRTypeCname {
+				continue
+			}
+			if name == zoneName {
+				return nil, fmt.Errorf("CNAME record is not permitted at the zone apex (%s)", name)
+			}
+			if len(types) > 1 {
+				return nil, fmt.Errorf("CNAME record (%s) cannot coexist with other record types", name)
+			}
+			if n := len(byKey[name+"_"+v].ResourceRecords); n > 1 {
+				return nil, fmt.Errorf("CNAME record (%s) has %d values, only one is permitted", name, n)
+			}
+		}
+	}
+
+	return recordSets, nil
+}
+
+// tokenizeZoneFile splits a zone file into logical lines of whitespace-separated tokens.
+// Quoted strings are returned with their quotes, parentheses join lines, and comments are removed.
+func tokenizeZoneFile(content string) ([]zoneFileLine, error) {
+	var lines []zoneFileLine
+	var line zoneFileLine
+	var token strings.Builder
+	var inQuote, inComment, inToken bool
+	parens, number, startNumber := 0, 1, 1
+	atLineStart := true
+
+	endToken := func() {
+		if inToken {
+			line.tokens = append(line.tokens, token.String())
+			token.Reset()
+			inToken = false
+		}
+	}
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+
+		if inComment {
+			if c != '\n' {
+				continue
+			}
+			inComment = false
+		}
+
+		if inQuote {
+			token.WriteByte(c)
+			switch c {
+			case '\\':
+				if i+1 < len(content) {
+					i++
+					token.WriteByte(content[i])
+				}
+			case '"':
+				inQuote = false
+			case '\n':
+				return nil, fmt.Errorf("line %d: unterminated quoted string", number)
+			}
+			continue
+		}
+
+		switch c {
+		case '\n':
+			endToken()
+			if parens == 0 {
+				if len(line.tokens) > 0 {
+					line.number = startNumber
+					lines = append(lines, line)
+				}
+				line = zoneFileLine{}
+				atLineStart = true
+			}
+			number++
+			if parens == 0 {
+				startNumber = number
+			}
+			continue
+		case ' ', '\t', '\r':
+			if atLineStart && len(line.tokens) == 0 {
+				line.blankOwner = true
+			}
+			endToken()
+		case ';':
+			endToken()
+			inComment = true
+		case '(':
+			endToken()
+			parens++
+		case ')':
+			endToken()
+			if parens == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", number)
+			}
+			parens--
+		case '"':
+			endToken()
+			token.WriteByte(c)
+			inToken, inQuote = true, true
+		case '\\':
+			token.WriteByte(c)
+			if i+1 < len(content) {
+				i++
+				token.WriteByte(content[i])
+			}
+			inToken = true
+		default:
+			token.WriteByte(c)
+			inToken = true
+		}
+		atLineStart = false
+	}
+
+	if inQuote {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", number)
+	}
+	if parens != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", number)
+	}
+
+	endToken()
+	if len(line.tokens) > 0 {
+		line.number = startNumber
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+// zoneFileRecordValue returns the Route 53 value of a record from its zone file data.
+func zoneFileRecordValue(recordType string, rdata []string, origin string) (string, error) {
+	switch recordType {
+	case route53.RRTypeTxt, route53.RRTypeSpf:
+		strs := make([]string, 0, len(rdata))
+		for _, v := range rdata {
+			s := v
+			if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+				s = s[1 : len(s)-1]
+			}
+			if n := zoneFileUnescapedLen(s); n > 255 {
+				return "", fmt.Errorf("%s string has %d characters, the maximum is 255", recordType, n)
+			}
+			strs = append(strs, `"`+s+`"`)
+		}
+		return strings.Join(strs, " "), nil
+	case route53.RRTypeCname, route53.RRTypeNs, route53.RRTypePtr:
+		if len(rdata) != 1 {
+			return "", fmt.Errorf("%s record requires a single domain name", recordType)
+		}
+		return zoneFileQualifyName(rdata[0], origin), nil
+	case route53.RRTypeMx:
+		if len(rdata) != 2 {
+			return "", fmt.Errorf("MX record requires a preference and a domain name")
+		}
+		return rdata[0] + " " + zoneFileQualifyName(rdata[1], origin), nil
+	case route53.RRTypeSrv:
+		if len(rdata) != 4 {
+			return "", fmt.Errorf("SRV record requires a priority, weight, port and target")
+		}
+		return strings.Join(append(rdata[:3:3], zoneFileQualifyName(rdata[3], origin)), " "), nil
+	}
+
+	return strings.Join(rdata, " "), nil
+}
+
+// zoneFileQualifyName returns the fully qualified form of a zone file domain name.
+func zoneFileQualifyName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	}
+
+	return name + "." + origin
+}
+
+// parseZoneFileTTL parses a TTL in seconds or in BIND unit notation, e.g. 1h30m.
+func parseZoneFileTTL(s string) (int64, error) {
+	if s == "" {
+		return 0, fmt.Errorf("empty TTL")
+	}
+
+	var ttl, n int64
+	digits := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= '0' && c <= '9' {
+			n = n*10 + int64(c-'0')
+			if n > math.MaxInt32 {
+				return 0, fmt.Errorf("TTL (%s) is out of range", s)
+			}
+			digits = true
+			continue
+		}
+
+		unit, ok := zoneFileTTLUnits[c|0x20]
+		if !ok || !digits {
+			return 0, fmt.Errorf("invalid TTL (%s)", s)
+		}
+		ttl += n * unit
+		n, digits = 0, false
+	}
+	ttl += n
+
+	if ttl > math.MaxInt32 {
+		return 0, fmt.Errorf("TTL (%s) is out of range", s)
+	}
+
+	return ttl, nil
+}
+
+// zoneFileUnescapedLen returns the length of a character string after zone file escapes are decoded.
+func zoneFileUnescapedLen(s string) int {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			if i+3 < len(s) && isDigit(s[i+1]) && isDigit(s[i+2]) && isDigit(s[i+3]) {
+				i += 3
+			} else {
+				i++
+			}
+		}
+		n++
+	}
+	return n
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_route53_zone_file")
+func DataSourceZoneFile() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceZoneFileRead,
+
+		Schema: map[string]*schema.Schema{
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"include_apex_ns": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceZoneFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	content := d.Get("content").(string)
+	recordSets, err := parseZoneFile(content, d.Get("origin").(string), int64(d.Get("default_ttl").(int)), d.Get("include_apex_ns").(bool))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing zone file: %s", err)
+	}
+
+	tfList := make([]interface{}, 0, len(recordSets))
+	for _, v := range recordSets {
+		recordType := aws.StringValue(v.Type)
+		tfList = append(tfList, map[string]interface{}{
+			"name":    aws.StringValue(v.Name),
+			"records": FlattenResourceRecords(v.ResourceRecords, recordType),
+			"ttl":     aws.Int64Value(v.TTL),
+			"type":    recordType,
+		})
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(content)))
+	if err := d.Set("records", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting records: %s", err)
+	}
+
+	return diags
+}
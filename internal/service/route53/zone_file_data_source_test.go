@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRoute53ZoneFileDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_zone_file.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, route53.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "records.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.name", "www.example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.type", "A"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.ttl", "300"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.records.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.1.name", "example.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.1.type", "TXT"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.1.ttl", "3600"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.1.records.0", `v=spf1 " "-all`),
+				),
+			},
+		},
+	})
+}
+
+const testAccZoneFileDataSourceConfig_basic = `
+data "aws_route53_zone_file" "test" {
+  origin  = "example.com"
+  content = <<-EOT
+    $TTL 1h
+    @    IN SOA ns1.example.com. hostmaster.example.com. ( 1 7200 3600 1209600 300 )
+    @    IN NS  ns1.example.com.
+    www  300 IN A 192.0.2.1
+         300 IN A 192.0.2.2
+    @    IN TXT "v=spf1 " "-all"
+  EOT
+}
+`
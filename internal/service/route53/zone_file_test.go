@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+)
+
+func TestRenderZoneFile(t *testing.T) {
+	t.Parallel()
+
+	recordSets := []*route53.ResourceRecordSet{
+		{
+			Name:            aws.String("example.com."),
+			Type:            aws.String(route53.RRTypeMx),
+			TTL:             aws.Int64(3600),
+			ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("10 mx1.example.com")}, {Value: aws.String("20 mx2.example.com")}},
+		},
+		{
+			Name:            aws.String("\\052.example.com."),
+			Type:            aws.String(route53.RRTypeTxt),
+			TTL:             aws.Int64(300),
+			ResourceRecords: []*route53.ResourceRecord{{Value: aws.String(`"v=spf1" "-all"`)}},
+		},
+		{
+			Name: aws.String("cdn.example.com."),
+			Type: aws.String(route53.RRTypeA),
+			AliasTarget: &route53.AliasTarget{
+				DNSName:      aws.String("d111111abcdef8.cloudfront.net"),
+				HostedZoneId: aws.String("Z2FDTNDATAQYW2"),
+			},
+		},
+		{
+			Name:            aws.String("www.example.com."),
+			Type:            aws.String(route53.RRTypeA),
+			TTL:             aws.Int64(60),
+			SetIdentifier:   aws.String("blue"),
+			Weight:          aws.Int64(10),
+			ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("192.0.2.1")}},
+		},
+	}
+
+	want := `$ORIGIN example.com.
+example.com.	3600	IN	MX	10 mx1.example.com
+example.com.	3600	IN	MX	20 mx2.example.com
+*.example.com.	300	IN	TXT	"v=spf1" "-all"
+; cdn.example.com. ALIAS A d111111abcdef8.cloudfront.net. Z2FDTNDATAQYW2
+; set identifier "blue"
+; www.example.com.	60	IN	A	192.0.2.1
+`
+
+	if got := tfroute53.RenderZoneFile("example.com", recordSets); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestParseZoneFile(t *testing.T) {
+	t.Parallel()
+
+	content := `$ORIGIN example.com.
+$TTL 1h
+; SOA and apex NS records are managed by Route 53.
+@	IN	SOA	ns1.example.com. hostmaster.example.com. (
+		2023010101 ; serial
+		7200       ; refresh
+		3600       ; retry
+		1209600    ; expire
+		300 )      ; minimum
+	IN	NS	ns1
+	IN	NS	ns2.example.com.
+@	IN	MX	10 mail
+	IN	MX	20 mail.backup.example.net.
+www	300	IN	A	192.0.2.1
+	IN	300	A	192.0.2.2
+WWW		A	192.0.2.2
+ftp	1d	CNAME	www
+txt		TXT	"v=spf1 include:example.net -all"
+long		TXT	( "first; half" 
+			  "second half" )
+_sip._tcp	SRV	0 5 5060 sip
+$ORIGIN sub.example.com.
+host	2w	IN	AAAA	2001:db8::1
+sub.example.com.	NS	ns1.example.net.
+`
+
+	got, err := tfroute53.ParseZoneFile(content, "example.com", -1, false)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []string{
+		"example.com MX 3600 [10 mail.example.com. 20 mail.backup.example.net.]",
+		"www.example.com A 300 [192.0.2.1 192.0.2.2]",
+		"ftp.example.com CNAME 86400 [www.example.com.]",
+		`txt.example.com TXT 3600 ["v=spf1 include:example.net -all"]`,
+		`long.example.com TXT 3600 ["first; half" "second half"]`,
+		"_sip._tcp.example.com SRV 3600 [0 5 5060 sip.example.com.]",
+		"host.sub.example.com AAAA 1209600 [2001:db8::1]",
+		"sub.example.com NS 3600 [ns1.example.net.]",
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d record sets, want %d", len(got), len(want))
+	}
+
+	for i, v := range got {
+		var values []string
+		for _, r := range v.ResourceRecords {
+			values = append(values, aws.StringValue(r.Value))
+		}
+
+		if s := fmt.Sprintf("%s %s %d %v", aws.StringValue(v.Name), aws.StringValue(v.Type), aws.Int64Value(v.TTL), values); s != want[i] {
+			t.Errorf("record set %d: got %s, want %s", i, s, want[i])
+		}
+	}
+}
+
+func TestParseZoneFile_apexNS(t *testing.T) {
+	t.Parallel()
+
+	got, err := tfroute53.ParseZoneFile("@ 300 IN NS ns1.example.net.\n", "example.com.", -1, true)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got) != 1 || aws.StringValue(got[0].Type) != route53.RRTypeNs {
+		t.Errorf("got %v, want apex NS record set", got)
+	}
+}
+
+func TestParseZoneFile_errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"include":         "$INCLUDE other.zone\n",
+		"no ttl":          "www IN A 192.0.2.1\n",
+		"no owner":        " 300 IN A 192.0.2.1\n",
+		"class":           "www 300 CH A 192.0.2.1\n",
+		"type":            "www 300 IN WKS 192.0.2.1\n",
+		"no data":         "www 300 IN A\n",
+		"out of zone":     "www.example.net. 300 IN A 192.0.2.1\n",
+		"unbalanced":      "www 300 IN TXT ( \"a\"\n",
+		"unterminated":    "www 300 IN TXT \"a\n",
+		"cname conflict":  "www 300 IN CNAME a\nwww 300 IN A 192.0.2.1\n",
+		"cname apex":      "@ 300 IN CNAME a\n",
+		"cname multiple":  "www 300 IN CNAME a\nwww 300 IN CNAME b\n",
+		"txt string size": "txt 300 IN TXT \"" + strings.Repeat("a", 256) + "\"\n",
+		"ttl":             "$TTL 99999999999\n",
+	}
+
+	for name, content := range testCases {
+		if _, err := tfroute53.ParseZoneFile(content, "example.com", -1, false); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -7,5 +7,9 @@ package s3
 var (
 	DeleteAllObjectVersions  = deleteAllObjectVersions
 	FindObjectByBucketAndKey = findObjectByBucketAndKey
+	ObjectChecksum           = objectChecksum
+	ObjectSyncCommonPrefix   = objectSyncCommonPrefix
+	ObjectSyncKeyPrefix      = objectSyncKeyPrefix
+	ObjectSyncPatternMatches = objectSyncPatternMatches
 	SDKv1CompatibleCleanKey  = sdkv1CompatibleCleanKey
 )
@@ -6,10 +6,16 @@ package s3
 import (
 	"bytes"
 	"context"
+	"crypto/sha1"
+	"crypto/sha256"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"hash"
+	"hash/crc32"
 	"io"
 	"log"
+	"math"
 	"net/http"
 	"os"
 	"strings"
@@ -203,6 +209,16 @@ func ResourceObject() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"upload_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(manager.MinUploadPartSize)),
+			},
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -281,7 +297,8 @@ func resourceObjectRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 func resourceObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	if hasObjectContentChanges(d) {
+	// Checksum attributes only change during an update when drift from the configured content has been detected.
+	if hasObjectContentChanges(d) || d.HasChanges("checksum_crc32", "checksum_crc32c", "checksum_sha1", "checksum_sha256") {
 		return append(diags, resourceObjectUpload(ctx, d, meta)...)
 	}
 
@@ -401,7 +418,14 @@ func resourceObjectImport(ctx context.Context, d *schema.ResourceData, meta inte
 func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).S3Client(ctx)
-	uploader := manager.NewUploader(conn)
+	uploader := manager.NewUploader(conn, func(u *manager.Uploader) {
+		if v, ok := d.GetOk("upload_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+		if v, ok := d.GetOk("upload_part_size"); ok {
+			u.PartSize = int64(v.(int))
+		}
+	})
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	tags := defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("tags").(map[string]interface{})))
 
@@ -523,7 +547,7 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
 	}
 
-	if _, err := uploader.Upload(ctx, input); err != nil {
+	if _, err := uploadObject(ctx, conn, uploader, input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
 	}
 
@@ -565,14 +589,63 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 	return
 }
 
-func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+func resourceObjectCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	if hasObjectContentChanges(d) {
 		return d.SetNewComputed("version_id")
 	}
 
 	if d.HasChange("source_hash") {
-		d.SetNewComputed("version_id")
-		d.SetNewComputed("etag")
+		if err := d.SetNewComputed("version_id"); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("etag"); err != nil {
+			return err
+		}
+	}
+
+	// Detect drift between the stored object's additional checksum and the configured content.
+	if d.Id() == "" || d.HasChanges("bucket", "key") {
+		return nil
+	}
+
+	v, ok := d.GetOk("checksum_algorithm")
+	if !ok {
+		return nil
+	}
+
+	checksumAlgorithm := types.ChecksumAlgorithm(v.(string))
+	attrName := objectChecksumAttribute(checksumAlgorithm)
+	stored := d.Get(attrName).(string)
+
+	if stored == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+	bucket, key := d.Get("bucket").(string), sdkv1CompatibleCleanKey(d.Get("key").(string))
+
+	// Only objects uploaded using multipart upload need a request to find their part size.
+	partSize, err := findObjectChecksumPartSize(ctx, conn, bucket, key, stored)
+
+	if err != nil {
+		log.Printf("[WARN] S3 Object (%s) part size: %s; not checking %s checksum", d.Id(), err, checksumAlgorithm)
+		return nil
+	}
+
+	checksum, err := objectContentChecksum(d, checksumAlgorithm, partSize)
+
+	if err != nil {
+		return err
+	}
+
+	if checksum != "" && checksum != stored {
+		log.Printf("[DEBUG] S3 Object (%s) %s checksum (%s) differs from configured content (%s)", d.Id(), checksumAlgorithm, stored, checksum)
+		if err := d.SetNewComputed(attrName); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("version_id"); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -605,6 +678,189 @@ func hasObjectContentChanges(d verify.ResourceDiffer) bool {
 	return false
 }
 
+// uploadObject uploads an object using the specified uploader.
+// Any incomplete multipart upload is aborted on failure so that no orphaned parts are left in the bucket.
+func uploadObject(ctx context.Context, conn *s3.Client, uploader *manager.Uploader, input *s3.PutObjectInput) (*manager.UploadOutput, error) {
+	uploader.LeavePartsOnError = true
+
+	output, err := uploader.Upload(ctx, input)
+
+	if err != nil {
+		var mu manager.MultiUploadFailure
+		if errors.As(err, &mu) && mu.UploadID() != "" {
+			// Use a fresh Context as the original may have been canceled.
+			ctx, cancel := context.WithTimeout(context.Background(), objectMultipartUploadAbortTimeout)
+			defer cancel()
+
+			input := &s3.AbortMultipartUploadInput{
+				Bucket:   input.Bucket,
+				Key:      input.Key,
+				UploadId: aws.String(mu.UploadID()),
+			}
+
+			log.Printf("[INFO] Aborting S3 Bucket (%s) Object (%s) multipart upload (%s)", aws.ToString(input.Bucket), aws.ToString(input.Key), mu.UploadID())
+			_, abortErr := conn.AbortMultipartUpload(ctx, input)
+
+			if abortErr != nil && !tfawserr.ErrCodeEquals(abortErr, errCodeNoSuchUpload) {
+				return nil, errors.Join(err, fmt.Errorf("aborting multipart upload (%s): %w", mu.UploadID(), abortErr))
+			}
+		}
+
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func objectChecksumAttribute(checksumAlgorithm types.ChecksumAlgorithm) string {
+	switch checksumAlgorithm {
+	case types.ChecksumAlgorithmCrc32:
+		return "checksum_crc32"
+	case types.ChecksumAlgorithmCrc32c:
+		return "checksum_crc32c"
+	case types.ChecksumAlgorithmSha1:
+		return "checksum_sha1"
+	case types.ChecksumAlgorithmSha256:
+		return "checksum_sha256"
+	default:
+		return ""
+	}
+}
+
+// objectContentChecksum returns the additional checksum that S3 is expected to report for the configured object content.
+// An empty string is returned if the content is not yet known.
+func objectContentChecksum(d *schema.ResourceDiff, checksumAlgorithm types.ChecksumAlgorithm, partSize int64) (string, error) {
+	for _, key := range []string{"content", "content_base64", "source"} {
+		if !d.NewValueKnown(key) {
+			return "", nil
+		}
+	}
+
+	var body io.ReadSeeker
+
+	if v, ok := d.GetOk("source"); ok {
+		source := v.(string)
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return "", fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			// The source may not exist until apply time.
+			if errors.Is(err, os.ErrNotExist) {
+				return "", nil
+			}
+
+			return "", fmt.Errorf("opening S3 object source (%s): %w", path, err)
+		}
+		defer file.Close()
+
+		body = file
+	} else if v, ok := d.GetOk("content"); ok {
+		body = strings.NewReader(v.(string))
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		contentRaw, err := base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return "", fmt.Errorf("decoding content_base64: %w", err)
+		}
+		body = bytes.NewReader(contentRaw)
+	} else {
+		body = bytes.NewReader([]byte{})
+	}
+
+	return objectChecksum(body, checksumAlgorithm, partSize)
+}
+
+// objectChecksum computes the base64-encoded additional checksum of an object's content as reported by S3.
+// Content larger than the part size is uploaded using multipart upload, in which case the checksum
+// is the checksum of the concatenated part checksums suffixed with the number of parts.
+// The part size calculation mirrors that of the S3 upload manager.
+func objectChecksum(r io.ReadSeeker, checksumAlgorithm types.ChecksumAlgorithm, partSize int64) (string, error) {
+	newHash := func() hash.Hash {
+		switch checksumAlgorithm {
+		case types.ChecksumAlgorithmCrc32:
+			return crc32.NewIEEE()
+		case types.ChecksumAlgorithmCrc32c:
+			return crc32.New(crc32.MakeTable(crc32.Castagnoli))
+		case types.ChecksumAlgorithmSha1:
+			return sha1.New()
+		case types.ChecksumAlgorithmSha256:
+			return sha256.New()
+		default:
+			return nil
+		}
+	}
+
+	if newHash() == nil {
+		return "", fmt.Errorf("unsupported checksum algorithm: %s", checksumAlgorithm)
+	}
+
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return "", err
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	if partSize <= 0 {
+		partSize = manager.DefaultUploadPartSize
+	}
+	if size/partSize >= int64(manager.MaxUploadParts) {
+		partSize = (size / int64(manager.MaxUploadParts)) + 1
+	}
+
+	if size <= partSize {
+		h := newHash()
+		if _, err := io.Copy(h, r); err != nil {
+			return "", err
+		}
+
+		return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+	}
+
+	composite := newHash()
+	var nParts int64
+	for offset := int64(0); offset < size; offset += partSize {
+		n := partSize
+		if bytesLeft := size - offset; bytesLeft < n {
+			n = bytesLeft
+		}
+
+		h := newHash()
+		if _, err := io.CopyN(h, r, n); err != nil {
+			return "", err
+		}
+		composite.Write(h.Sum(nil))
+		nParts++
+	}
+
+	return fmt.Sprintf("%s-%d", base64.StdEncoding.EncodeToString(composite.Sum(nil)), nParts), nil
+}
+
+// findObjectChecksumPartSize returns the part size with which an object's additional checksum was calculated.
+// The checksum of an object uploaded using multipart upload is suffixed with the number of parts,
+// in which case the size of the first part is read from S3 as the part size may since have been changed.
+func findObjectChecksumPartSize(ctx context.Context, conn *s3.Client, bucket, key, checksum string) (int64, error) {
+	if !strings.Contains(checksum, "-") {
+		return math.MaxInt64, nil
+	}
+
+	input := &s3.HeadObjectInput{
+		Bucket:     aws.String(bucket),
+		Key:        aws.String(key),
+		PartNumber: 1,
+	}
+
+	output, err := findObject(ctx, conn, input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return output.ContentLength, nil
+}
+
 func findObjectByBucketAndKey(ctx context.Context, conn *s3.Client, bucket, key, etag, checksumAlgorithm string) (*s3.HeadObjectOutput, error) {
 	input := &s3.HeadObjectInput{
 		Bucket: aws.String(bucket),
@@ -0,0 +1,893 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/mitchellh/go-homedir"
+	"golang.org/x/exp/maps"
+)
+
+// @SDKResource("aws_s3_object_sync", name="Object Sync")
+func ResourceObjectSync() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceObjectSyncCreate,
+		ReadWithoutTimeout:   resourceObjectSyncRead,
+		UpdateWithoutTimeout: resourceObjectSyncUpdate,
+		DeleteWithoutTimeout: resourceObjectSyncDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceObjectSyncImport,
+		},
+
+		CustomizeDiff: resourceObjectSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"acl": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ObjectCannedACL](),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"delete_extraneous": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"key_prefix"},
+			},
+			"etags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateObjectSyncPattern,
+				},
+			},
+			"files": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"key_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return objectSyncKeyPrefix(old) == objectSyncKeyPrefix(new)
+				},
+			},
+			"kms_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"metadata": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateMetadataIsLowerCase,
+			},
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_control": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_disposition": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_encoding": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_language": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"metadata": {
+							Type:         schema.TypeMap,
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ValidateFunc: validateMetadataIsLowerCase,
+						},
+						"pattern": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateObjectSyncPattern,
+						},
+					},
+				},
+			},
+			"server_side_encryption": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ServerSideEncryption](),
+			},
+			"source_dir": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"storage_class": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ObjectStorageClass](),
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"upload_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(int(manager.MinUploadPartSize)),
+			},
+		},
+	}
+}
+
+func resourceObjectSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId(objectSyncCreateResourceID(d.Get("bucket").(string), objectSyncKeyPrefix(d.Get("key_prefix").(string))))
+
+	if err := objectSync(ctx, d, meta, true); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating S3 Object Sync (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceObjectSyncRead(ctx, d, meta)...)
+}
+
+func resourceObjectSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket, keyPrefix := d.Get("bucket").(string), objectSyncKeyPrefix(d.Get("key_prefix").(string))
+	files := flex.ExpandStringValueMap(d.Get("files").(map[string]interface{}))
+	etags := flex.ExpandStringValueMap(d.Get("etags").(map[string]interface{}))
+
+	var remote map[string]string
+	var err error
+
+	// Listing objects needs a request per 1,000 objects rather than one per object.
+	// Without a key prefix only the objects under the longest prefix common to the tracked objects are listed.
+	switch {
+	case keyPrefix != "":
+		remote, err = findObjectETagsByBucketAndPrefix(ctx, conn, bucket, keyPrefix)
+	case len(etags) > 0:
+		remote, err = findObjectETagsByBucketAndPrefix(ctx, conn, bucket, objectSyncCommonPrefix(maps.Keys(etags)))
+	default:
+		remote, err = map[string]string{}, findBucketByName(ctx, conn, bucket)
+	}
+
+	// Only objects tracked by this resource are considered unless extraneous objects are to be deleted.
+	if err == nil && !d.Get("delete_extraneous").(bool) {
+		for key := range remote {
+			if _, ok := etags[key]; !ok {
+				delete(remote, key)
+			}
+		}
+	}
+
+	if !d.IsNewResource() && (tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket)) {
+		log.Printf("[WARN] S3 Object Sync (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Object Sync (%s): %s", d.Id(), err)
+	}
+
+	for key, etag := range etags {
+		// Objects that have been modified or removed out-of-band are uploaded again.
+		if v, ok := remote[key]; !ok || v != etag {
+			log.Printf("[DEBUG] S3 Object Sync (%s) object (%s) has changed", d.Id(), key)
+			delete(files, key)
+			delete(etags, key)
+		}
+	}
+
+	if !d.Get("delete_extraneous").(bool) {
+		for key, hash := range files {
+			if hash == "" {
+				delete(files, key)
+				delete(etags, key)
+			}
+		}
+	} else {
+		for key, etag := range remote {
+			// Objects not uploaded by this resource are tracked with an empty hash so that they are removed.
+			if _, ok := etags[key]; !ok {
+				files[key] = ""
+				etags[key] = etag
+			}
+		}
+	}
+
+	d.Set("files", files)
+	d.Set("etags", etags)
+
+	return diags
+}
+
+func resourceObjectSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChange("files") || hasObjectSyncSettingsChanges(d) {
+		if err := objectSync(ctx, d, meta, hasObjectSyncSettingsChanges(d)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating S3 Object Sync (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceObjectSyncRead(ctx, d, meta)...)
+}
+
+func resourceObjectSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	// Extraneous objects, tracked with an empty hash, were not uploaded by this resource.
+	files := d.Get("files").(map[string]interface{})
+	var keys []string
+	for key := range d.Get("etags").(map[string]interface{}) {
+		if hash, ok := files[key]; !ok || hash.(string) != "" {
+			keys = append(keys, key)
+		}
+	}
+
+	bucket := d.Get("bucket").(string)
+	err := deleteObjectsByKey(ctx, conn, bucket, keys)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Object Sync (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceObjectSyncImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	bucket, keyPrefix, ok := strings.Cut(d.Id(), "/")
+
+	if !ok || bucket == "" {
+		return nil, fmt.Errorf("unexpected format for ID (%[1]s), expected BUCKET/KEY_PREFIX", d.Id())
+	}
+
+	d.Set("bucket", bucket)
+	d.Set("delete_extraneous", false)
+	d.Set("key_prefix", keyPrefix)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceObjectSyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Without a key prefix every object in the bucket would be considered extraneous.
+	if d.Get("delete_extraneous").(bool) && d.NewValueKnown("key_prefix") && d.Get("key_prefix").(string) == "" {
+		return errors.New(`"key_prefix" must be set when "delete_extraneous" is true`)
+	}
+
+	if !d.NewValueKnown("source_dir") || !d.NewValueKnown("exclude") || !d.NewValueKnown("key_prefix") {
+		if err := d.SetNewComputed("files"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("etags")
+	}
+
+	sourceDir, err := homedir.Expand(d.Get("source_dir").(string))
+	if err != nil {
+		return fmt.Errorf("expanding homedir in source_dir (%s): %w", d.Get("source_dir").(string), err)
+	}
+
+	files, err := objectSyncSourceFiles(sourceDir, objectSyncKeyPrefix(d.Get("key_prefix").(string)), flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set)))
+	if err != nil {
+		return err
+	}
+
+	hashes := make(map[string]interface{}, len(files))
+	for key, file := range files {
+		hashes[key] = file.hash
+	}
+
+	old, _ := d.GetChange("files")
+	if d.Id() == "" || !objectSyncHashesEqual(old.(map[string]interface{}), hashes) {
+		if err := d.SetNew("files", hashes); err != nil {
+			return err
+		}
+		return d.SetNewComputed("etags")
+	}
+
+	return nil
+}
+
+func hasObjectSyncSettingsChanges(d *schema.ResourceData) bool {
+	return d.HasChanges(
+		"acl",
+		"cache_control",
+		"kms_key_id",
+		"metadata",
+		"rule",
+		"server_side_encryption",
+		"storage_class",
+	)
+}
+
+// objectSyncKeyPrefix returns the key prefix normalized to end in "/", so that
+// objects under sibling prefixes (e.g. "site-old/" for "site") are never matched.
+func objectSyncKeyPrefix(keyPrefix string) string {
+	if keyPrefix != "" && !strings.HasSuffix(keyPrefix, "/") {
+		return keyPrefix + "/"
+	}
+
+	return keyPrefix
+}
+
+func objectSyncCreateResourceID(bucket, keyPrefix string) string {
+	return bucket + "/" + keyPrefix
+}
+
+// objectSyncCommonPrefix returns the longest prefix common to all the specified keys.
+func objectSyncCommonPrefix(keys []string) string {
+	if len(keys) == 0 {
+		return ""
+	}
+
+	prefix := keys[0]
+	for _, key := range keys[1:] {
+		for !strings.HasPrefix(key, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	return prefix
+}
+
+// objectSync uploads new and changed files and deletes objects no longer present in the source directory.
+// If uploadAll is true then all files are uploaded.
+// Files are uploaded in parallel and each uploaded object is recorded in state, even if other uploads fail.
+func objectSync(ctx context.Context, d *schema.ResourceData, meta interface{}, uploadAll bool) error {
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+	concurrency := manager.DefaultUploadConcurrency
+	if v, ok := d.GetOk("upload_concurrency"); ok {
+		concurrency = v.(int)
+	}
+	uploader := manager.NewUploader(conn, func(u *manager.Uploader) {
+		u.Concurrency = concurrency
+		if v, ok := d.GetOk("upload_part_size"); ok {
+			u.PartSize = int64(v.(int))
+		}
+	})
+
+	bucket, keyPrefix := d.Get("bucket").(string), objectSyncKeyPrefix(d.Get("key_prefix").(string))
+	sourceDir, err := homedir.Expand(d.Get("source_dir").(string))
+	if err != nil {
+		return fmt.Errorf("expanding homedir in source_dir (%s): %w", d.Get("source_dir").(string), err)
+	}
+
+	files, err := objectSyncSourceFiles(sourceDir, keyPrefix, flex.ExpandStringValueSet(d.Get("exclude").(*schema.Set)))
+	if err != nil {
+		return err
+	}
+
+	o, _ := d.GetChange("files")
+	oldFiles := flex.ExpandStringValueMap(o.(map[string]interface{}))
+	oe, _ := d.GetChange("etags")
+	etags := flex.ExpandStringValueMap(oe.(map[string]interface{}))
+	input := expandObjectSyncPutObjectInput(d)
+	rules := expandObjectSyncRules(d.Get("rule").([]interface{}))
+
+	keys := make([]string, 0, len(files))
+	for key, file := range files {
+		if uploadAll || oldFiles[key] != file.hash {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	uploaded, err := objectSyncUploadFiles(ctx, conn, uploader, concurrency, input, bucket, keys, files, rules)
+
+	hashes := maps.Clone(oldFiles)
+	for key, etag := range uploaded {
+		hashes[key] = files[key].hash
+		etags[key] = etag
+	}
+
+	if err != nil {
+		// Objects that were to be uploaded with changed settings are only tracked by their ETag
+		// so that they are uploaded again.
+		if uploadAll {
+			for _, key := range keys {
+				if _, ok := uploaded[key]; !ok {
+					delete(hashes, key)
+				}
+			}
+		}
+
+		d.Set("files", hashes)
+		d.Set("etags", etags)
+
+		return err
+	}
+
+	var toDelete []string
+	for key := range etags {
+		if _, ok := files[key]; !ok {
+			toDelete = append(toDelete, key)
+		}
+	}
+
+	if err := deleteObjectsByKey(ctx, conn, bucket, toDelete); err != nil {
+		d.Set("files", hashes)
+		d.Set("etags", etags)
+
+		return err
+	}
+
+	hashes = make(map[string]string, len(files))
+	for key, file := range files {
+		hashes[key] = file.hash
+	}
+	for _, key := range toDelete {
+		delete(etags, key)
+	}
+
+	d.Set("files", hashes)
+	d.Set("etags", etags)
+
+	return nil
+}
+
+// objectSyncUploadFiles uploads the files with the specified keys, at most concurrency at a time.
+// No further uploads are started once an upload fails.
+// It returns a map of object key to ETag of the objects that were uploaded.
+func objectSyncUploadFiles(ctx context.Context, conn *s3.Client, uploader *manager.Uploader, concurrency int, input s3.PutObjectInput, bucket string, keys []string, files map[string]objectSyncSourceFile, rules []objectSyncRule) (map[string]string, error) {
+	var (
+		errs     []error
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		uploaded = make(map[string]string, len(keys))
+		sem      = make(chan struct{}, concurrency)
+	)
+
+	for _, key := range keys {
+		sem <- struct{}{}
+
+		mu.Lock()
+		failed := len(errs) > 0
+		mu.Unlock()
+
+		if failed {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		go func(key string, file objectSyncSourceFile) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			etag, err := objectSyncUploadFile(ctx, conn, uploader, input, bucket, key, file, rules)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = append(errs, err)
+				return
+			}
+
+			uploaded[key] = etag
+		}(key, files[key])
+	}
+
+	wg.Wait()
+
+	return uploaded, errors.Join(errs...)
+}
+
+// expandObjectSyncPutObjectInput returns the PutObject input settings that apply to every file.
+func expandObjectSyncPutObjectInput(d *schema.ResourceData) s3.PutObjectInput {
+	var input s3.PutObjectInput
+
+	if v, ok := d.GetOk("acl"); ok {
+		input.ACL = types.ObjectCannedACL(v.(string))
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		input.CacheControl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.SSEKMSKeyId = aws.String(v.(string))
+		input.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+	}
+
+	if v, ok := d.GetOk("metadata"); ok {
+		input.Metadata = flex.ExpandStringValueMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		input.ServerSideEncryption = types.ServerSideEncryption(v.(string))
+	}
+
+	if v, ok := d.GetOk("storage_class"); ok {
+		input.StorageClass = types.StorageClass(v.(string))
+	}
+
+	return input
+}
+
+func objectSyncUploadFile(ctx context.Context, conn *s3.Client, uploader *manager.Uploader, input s3.PutObjectInput, bucket, key string, file objectSyncSourceFile, rules []objectSyncRule) (string, error) {
+	f, err := os.Open(file.path)
+	if err != nil {
+		return "", fmt.Errorf("opening S3 object source (%s): %w", file.path, err)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Printf("[WARN] Error closing S3 object source (%s): %s", file.path, err)
+		}
+	}()
+
+	input.Body = f
+	input.Bucket = aws.String(bucket)
+	input.Key = aws.String(key)
+
+	metadata := make(map[string]string)
+	for k, v := range input.Metadata {
+		metadata[k] = v
+	}
+
+	// Matching rules are applied in order, later rules taking precedence.
+	for _, rule := range rules {
+		if !rule.matches(file.relPath) {
+			continue
+		}
+
+		if rule.cacheControl != "" {
+			input.CacheControl = aws.String(rule.cacheControl)
+		}
+		if rule.contentDisposition != "" {
+			input.ContentDisposition = aws.String(rule.contentDisposition)
+		}
+		if rule.contentEncoding != "" {
+			input.ContentEncoding = aws.String(rule.contentEncoding)
+		}
+		if rule.contentLanguage != "" {
+			input.ContentLanguage = aws.String(rule.contentLanguage)
+		}
+		if rule.contentType != "" {
+			input.ContentType = aws.String(rule.contentType)
+		}
+		for k, v := range rule.metadata {
+			metadata[k] = v
+		}
+	}
+
+	input.Metadata = nil
+	if len(metadata) > 0 {
+		input.Metadata = metadata
+	}
+
+	if input.ContentType == nil {
+		contentType, err := detectContentType(f)
+		if err != nil {
+			return "", fmt.Errorf("detecting content type of S3 object source (%s): %w", file.path, err)
+		}
+		input.ContentType = aws.String(contentType)
+	}
+
+	log.Printf("[DEBUG] Uploading S3 Object Sync file (%s) to Bucket (%s) Object (%s)", file.path, bucket, key)
+	output, err := uploadObject(ctx, conn, uploader, &input)
+
+	if err != nil {
+		return "", fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", key, bucket, err)
+	}
+
+	return strings.Trim(aws.ToString(output.ETag), `"`), nil
+}
+
+// detectContentType returns the MIME type of a file based on its extension or, failing that, its content.
+func detectContentType(f *os.File) (string, error) {
+	if v := mime.TypeByExtension(filepath.Ext(f.Name())); v != "" {
+		return v, nil
+	}
+
+	buf := make([]byte, 512)
+	n, err := f.Read(buf)
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(buf[:n]), nil
+}
+
+type objectSyncSourceFile struct {
+	path    string
+	relPath string
+	hash    string
+}
+
+// objectSyncSourceFiles walks the source directory, returning a map of object key to source file.
+// Files matching any of the exclude patterns are ignored.
+func objectSyncSourceFiles(sourceDir, keyPrefix string, exclude []string) (map[string]objectSyncSourceFile, error) {
+	files := make(map[string]objectSyncSourceFile)
+
+	err := filepath.WalkDir(sourceDir, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		for _, pattern := range exclude {
+			if objectSyncPatternMatches(pattern, rel) {
+				return nil
+			}
+		}
+
+		hash, err := fileMD5(p)
+		if err != nil {
+			return err
+		}
+
+		files[sdkv1CompatibleCleanKey(keyPrefix+rel)] = objectSyncSourceFile{
+			path:    p,
+			relPath: rel,
+			hash:    hash,
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source directory (%s): %w", sourceDir, err)
+	}
+
+	return files, nil
+}
+
+func fileMD5(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := md5.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+type objectSyncRule struct {
+	cacheControl       string
+	contentDisposition string
+	contentEncoding    string
+	contentLanguage    string
+	contentType        string
+	metadata           map[string]string
+	pattern            string
+}
+
+func (r objectSyncRule) matches(relPath string) bool {
+	return objectSyncPatternMatches(r.pattern, relPath)
+}
+
+// objectSyncPatternMatches returns whether the specified slash-separated path relative to the source directory matches a pattern.
+// Patterns without a '/' are matched against the file's base name, otherwise against the whole relative path.
+func objectSyncPatternMatches(pattern, relPath string) bool {
+	name := relPath
+	if !strings.Contains(pattern, "/") {
+		name = path.Base(relPath)
+	}
+
+	matched, _ := path.Match(pattern, name)
+
+	return matched
+}
+
+func validateObjectSyncPattern(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := path.Match(v.(string), ""); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is not a valid pattern: %w", k, v.(string), err))
+	}
+	return
+}
+
+func objectSyncHashesEqual(a, b map[string]interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for k, v := range a {
+		if w, ok := b[k]; !ok || v != w {
+			return false
+		}
+	}
+
+	return true
+}
+
+func expandObjectSyncRules(tfList []interface{}) []objectSyncRule {
+	var apiObjects []objectSyncRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := objectSyncRule{
+			cacheControl:       tfMap["cache_control"].(string),
+			contentDisposition: tfMap["content_disposition"].(string),
+			contentEncoding:    tfMap["content_encoding"].(string),
+			contentLanguage:    tfMap["content_language"].(string),
+			contentType:        tfMap["content_type"].(string),
+			metadata:           flex.ExpandStringValueMap(tfMap["metadata"].(map[string]interface{})),
+			pattern:            tfMap["pattern"].(string),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// findObjectETagsByBucketAndPrefix returns a map of object key to ETag for all objects with the specified prefix.
+func findObjectETagsByBucketAndPrefix(ctx context.Context, conn *s3.Client, bucket, prefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+
+	output := make(map[string]string)
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			output[aws.ToString(v.Key)] = strings.Trim(aws.ToString(v.ETag), `"`)
+		}
+	}
+
+	return output, nil
+}
+
+// findBucketByName returns a NotFoundError if the specified bucket does not exist.
+func findBucketByName(ctx context.Context, conn *s3.Client, bucket string) error {
+	input := &s3.HeadBucketInput{
+		Bucket: aws.String(bucket),
+	}
+
+	_, err := conn.HeadBucket(ctx, input)
+
+	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+		return &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	return err
+}
+
+// deleteObjectsByKey deletes the current versions of the specified objects in batches.
+func deleteObjectsByKey(ctx context.Context, conn *s3.Client, bucket string, keys []string) error {
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > keyRequestPageSize {
+			n = keyRequestPageSize
+		}
+
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &types.Delete{
+				Quiet: true,
+			},
+		}
+		for _, key := range keys[:n] {
+			input.Delete.Objects = append(input.Delete.Objects, types.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+
+		log.Printf("[INFO] Deleting %d S3 Bucket (%s) Objects", n, bucket)
+		output, err := conn.DeleteObjects(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("deleting S3 Bucket (%s) Objects: %w", bucket, err)
+		}
+
+		for _, v := range output.Errors {
+			if aws.ToString(v.Code) == errCodeNoSuchKey {
+				continue
+			}
+
+			return fmt.Errorf("deleting S3 Bucket (%s) Object (%s): %s: %s", bucket, aws.ToString(v.Key), aws.ToString(v.Code), aws.ToString(v.Message))
+		}
+
+		keys = keys[n:]
+	}
+
+	return nil
+}
@@ -0,0 +1,605 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestObjectSyncPatternMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pattern string
+		relPath string
+		want    bool
+	}{
+		{
+			pattern: "*.html",
+			relPath: "index.html",
+			want:    true,
+		},
+		{
+			pattern: "*.html",
+			relPath: "docs/guide/index.html",
+			want:    true,
+		},
+		{
+			pattern: "*.html",
+			relPath: "style.css",
+		},
+		{
+			pattern: "assets/*",
+			relPath: "assets/logo.png",
+			want:    true,
+		},
+		{
+			pattern: "assets/*",
+			relPath: "assets/img/logo.png",
+		},
+		{
+			pattern: "assets/*/*.png",
+			relPath: "assets/img/logo.png",
+			want:    true,
+		},
+		{
+			pattern: "robots.txt",
+			relPath: "robots.txt",
+			want:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(fmt.Sprintf("%s %s", testCase.pattern, testCase.relPath), func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfs3.ObjectSyncPatternMatches(testCase.pattern, testCase.relPath), testCase.want; got != want {
+				t.Errorf("ObjectSyncPatternMatches(%q, %q) = %v, want %v", testCase.pattern, testCase.relPath, got, want)
+			}
+		})
+	}
+}
+
+func TestObjectSyncCommonPrefix(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		keys []string
+		want string
+	}{
+		{},
+		{keys: []string{"index.html"}, want: "index.html"},
+		{keys: []string{"site/index.html", "site/css/style.css"}, want: "site/"},
+		{keys: []string{"site/index.html", "site-old/index.html"}, want: "site"},
+		{keys: []string{"index.html", "css/style.css"}, want: ""},
+	}
+
+	for _, testCase := range testCases {
+		if got := tfs3.ObjectSyncCommonPrefix(testCase.keys); got != testCase.want {
+			t.Errorf("ObjectSyncCommonPrefix(%q) = %q, want %q", testCase.keys, got, testCase.want)
+		}
+	}
+}
+
+func TestObjectSyncKeyPrefix(t *testing.T) {
+	t.Parallel()
+
+	for keyPrefix, want := range map[string]string{
+		"":          "",
+		"site":      "site/",
+		"site/":     "site/",
+		"site/docs": "site/docs/",
+	} {
+		if got := tfs3.ObjectSyncKeyPrefix(keyPrefix); got != want {
+			t.Errorf("ObjectSyncKeyPrefix(%q) = %q, want %q", keyPrefix, got, want)
+		}
+	}
+}
+
+func TestAccS3ObjectSync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_object_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccObjectSyncCreateSourceDir(t, map[string]string{
+		"index.html":      "<html></html>",
+		"css/style.css":   "body {}",
+		"img/data.bin":    "\x00\x01\x02",
+		"scratch/tmp.txt": "ignored",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectSyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket", rName),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "files.site/index.html", "c83301425b2ad1d496473a5ff3d9ecca"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					testAccCheckObjectSyncObjectContentType(ctx, resourceName, "site/index.html", "text/html; charset=utf-8"),
+					testAccCheckObjectSyncObjectContentType(ctx, resourceName, "site/css/style.css", "text/css; charset=utf-8"),
+					testAccCheckObjectSyncObjectContentType(ctx, resourceName, "site/img/data.bin", "application/octet-stream"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"etags", "exclude", "files", "source_dir"},
+			},
+			{
+				PreConfig: func() {
+					testAccObjectSyncWriteSourceFile(t, sourceDir, "index.html", "<html><body></body></html>")
+					testAccObjectSyncWriteSourceFile(t, sourceDir, "about.html", "<html></html>")
+					if err := os.Remove(filepath.Join(sourceDir, "css", "style.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectSyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/about.html"),
+					resource.TestCheckNoResourceAttr(resourceName, "files.site/css/style.css"),
+					testAccCheckObjectSyncObjectNotExists(ctx, resourceName, "site/css/style.css"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectSync_keyPrefixTrailingSlash(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_object_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccObjectSyncCreateSourceDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectSyncConfig_keyPrefix(rName, sourceDir, "site"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", rName+"/site/"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.site/index.html"),
+				),
+			},
+			{
+				Config:   testAccObjectSyncConfig_keyPrefix(rName, sourceDir, "site/"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectSync_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_object_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccObjectSyncCreateSourceDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectSyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfs3.ResourceObjectSync(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectSync_deleteExtraneous(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_object_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccObjectSyncCreateSourceDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectSyncConfig_deleteExtraneous(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_extraneous", "true"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "files.site/index.html", "c83301425b2ad1d496473a5ff3d9ecca"),
+					testAccCheckObjectSyncPutObject(ctx, resourceName, "site/extraneous.txt"),
+					testAccCheckObjectSyncPutObject(ctx, resourceName, "site-old/index.html"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectSyncConfig_deleteExtraneous(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					testAccCheckObjectSyncObjectNotExists(ctx, resourceName, "site/extraneous.txt"),
+					// Objects under sibling prefixes are left alone.
+					testAccCheckObjectSyncObject(ctx, resourceName, "site-old/index.html", func(*s3.HeadObjectOutput) error { return nil }),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectSync_deleteExtraneousWithoutKeyPrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccObjectSyncCreateSourceDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccObjectSyncConfig_deleteExtraneousWithoutKeyPrefix(rName, sourceDir),
+				ExpectError: regexache.MustCompile(`all of .delete_extraneous,key_prefix. must be specified`),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectSync_uploadFailure(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_object_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccObjectSyncCreateSourceDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectSyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccObjectSyncWriteSourceFile(t, sourceDir, "denied.txt", "denied")
+					testAccObjectSyncWriteSourceFile(t, sourceDir, "index.html", "<html><body></body></html>")
+				},
+				Config:      testAccObjectSyncConfig_denyPutObject(rName, sourceDir),
+				ExpectError: regexache.MustCompile(`updating S3 Object Sync`),
+			},
+			// Files that were not synced are uploaded by the next apply.
+			{
+				Config:             testAccObjectSyncConfig_denyPutObject(rName, sourceDir),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectSync_rules(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_s3_object_sync.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccObjectSyncCreateSourceDir(t, map[string]string{
+		"index.html":      "<html></html>",
+		"assets/app.js":   "console.log(1);",
+		"assets/app.map":  "{}",
+		"data/report.dat": "report",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectSyncConfig_rules(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "exclude.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					testAccCheckObjectSyncObjectCacheControl(ctx, resourceName, "site/index.html", "no-cache"),
+					testAccCheckObjectSyncObjectCacheControl(ctx, resourceName, "site/assets/app.js", "max-age=31536000"),
+					testAccCheckObjectSyncObjectContentType(ctx, resourceName, "site/data/report.dat", "text/csv"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectSyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_object_sync" {
+				continue
+			}
+
+			for k := range rs.Primary.Attributes {
+				key, ok := strings.CutPrefix(k, "files.")
+				if !ok || key == "%" {
+					continue
+				}
+
+				_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], key, "", "")
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("S3 Object Sync %s object %s still exists", rs.Primary.ID, key)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectSyncPutObject(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := conn.PutObject(ctx, &s3.PutObjectInput{
+			Body:   strings.NewReader("extraneous"),
+			Bucket: aws.String(rs.Primary.Attributes["bucket"]),
+			Key:    aws.String(key),
+		})
+
+		return err
+	}
+}
+
+func testAccCheckObjectSyncObjectNotExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], key, "", "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object %s still exists", key)
+	}
+}
+
+func testAccCheckObjectSyncObjectContentType(ctx context.Context, n, key, want string) resource.TestCheckFunc {
+	return testAccCheckObjectSyncObject(ctx, n, key, func(output *s3.HeadObjectOutput) error {
+		if got := aws.ToString(output.ContentType); got != want {
+			return fmt.Errorf("S3 Object %s Content-Type = %q, want %q", key, got, want)
+		}
+
+		return nil
+	})
+}
+
+func testAccCheckObjectSyncObjectCacheControl(ctx context.Context, n, key, want string) resource.TestCheckFunc {
+	return testAccCheckObjectSyncObject(ctx, n, key, func(output *s3.HeadObjectOutput) error {
+		if got := aws.ToString(output.CacheControl); got != want {
+			return fmt.Errorf("S3 Object %s Cache-Control = %q, want %q", key, got, want)
+		}
+
+		return nil
+	})
+}
+
+func testAccCheckObjectSyncObject(ctx context.Context, n, key string, check func(*s3.HeadObjectOutput) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes["bucket"], key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		return check(output)
+	}
+}
+
+func testAccObjectSyncCreateSourceDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	sourceDir := t.TempDir()
+
+	for name, content := range files {
+		testAccObjectSyncWriteSourceFile(t, sourceDir, name, content)
+	}
+
+	return sourceDir
+}
+
+func testAccObjectSyncWriteSourceFile(t *testing.T, sourceDir, name, content string) {
+	t.Helper()
+
+	path := filepath.Join(sourceDir, filepath.FromSlash(name))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccObjectSyncConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccObjectSyncConfig_basic(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccObjectSyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[1]q
+
+  exclude = ["scratch/*"]
+}
+`, sourceDir))
+}
+
+func testAccObjectSyncConfig_deleteExtraneous(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccObjectSyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site"
+  source_dir = %[1]q
+
+  delete_extraneous = true
+}
+`, sourceDir))
+}
+
+func testAccObjectSyncConfig_deleteExtraneousWithoutKeyPrefix(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccObjectSyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[1]q
+
+  delete_extraneous = true
+}
+`, sourceDir))
+}
+
+func testAccObjectSyncConfig_denyPutObject(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccObjectSyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Deny"
+      Principal = "*"
+      Action    = "s3:PutObject"
+      Resource  = "${aws_s3_bucket.test.arn}/site/denied.txt"
+    }]
+  })
+}
+
+resource "aws_s3_object_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source_dir = %[1]q
+
+  exclude = ["scratch/*"]
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, sourceDir))
+}
+
+func testAccObjectSyncConfig_rules(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccObjectSyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object_sync" "test" {
+  bucket        = aws_s3_bucket.test.bucket
+  key_prefix    = "site/"
+  source_dir    = %[1]q
+  cache_control = "no-cache"
+
+  exclude = ["*.map"]
+
+  rule {
+    pattern       = "assets/*"
+    cache_control = "max-age=31536000"
+  }
+
+  rule {
+    pattern      = "*.dat"
+    content_type = "text/csv"
+
+    metadata = {
+      source = "report"
+    }
+  }
+}
+`, sourceDir))
+}
+
+func testAccObjectSyncConfig_keyPrefix(rName, sourceDir, keyPrefix string) string {
+	return acctest.ConfigCompose(testAccObjectSyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = %[2]q
+  source_dir = %[1]q
+}
+`, sourceDir, keyPrefix))
+}
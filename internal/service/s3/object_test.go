@@ -4,13 +4,16 @@
 package s3_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
 	"errors"
 	"fmt"
 	"io"
 	"os"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -104,6 +107,93 @@ func TestSDKv1CompatibleCleanKey(t *testing.T) {
 	}
 }
 
+func TestObjectChecksum(t *testing.T) {
+	t.Parallel()
+
+	const partSize = 5 * 1024 * 1024
+	large := bytes.Repeat([]byte("A"), 2*partSize+1024)
+
+	testCases := []struct {
+		name              string
+		content           []byte
+		checksumAlgorithm types.ChecksumAlgorithm
+		partSize          int64
+		want              string
+		wantErr           bool
+	}{
+		{
+			name:              "empty CRC32",
+			checksumAlgorithm: types.ChecksumAlgorithmCrc32,
+			want:              "AAAAAA==",
+		},
+		{
+			name:              "CRC32",
+			content:           []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+			checksumAlgorithm: types.ChecksumAlgorithmCrc32,
+			want:              "q/d4Ig==",
+		},
+		{
+			name:              "SHA1",
+			content:           []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+			checksumAlgorithm: types.ChecksumAlgorithmSha1,
+			want:              "gCVvOanTCGUKyQ2b6acqlWJFRXQ=",
+		},
+		{
+			name:              "SHA256",
+			content:           []byte("ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+			checksumAlgorithm: types.ChecksumAlgorithmSha256,
+			want:              "1uxomN6H3axuWzYRcIp6ocLSmCkzScwabCmaHbcUnTg=",
+		},
+		{
+			name:              "multipart CRC32",
+			content:           large,
+			checksumAlgorithm: types.ChecksumAlgorithmCrc32,
+			partSize:          partSize,
+			want:              "vM8TgQ==-3",
+		},
+		{
+			name:              "multipart SHA256 default part size",
+			content:           large,
+			checksumAlgorithm: types.ChecksumAlgorithmSha256,
+			want:              "ZO9JeqL03u1eYm4L2ykEGUqvIHwbrP1/lCbvcCazbfQ=-3",
+		},
+		{
+			name:              "single part when part size exceeds content length",
+			content:           large,
+			checksumAlgorithm: types.ChecksumAlgorithmSha256,
+			partSize:          4 * partSize,
+			want:              base64.StdEncoding.EncodeToString(sha256Sum(large)),
+		},
+		{
+			name:              "unsupported algorithm",
+			checksumAlgorithm: "MD5",
+			wantErr:           true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfs3.ObjectChecksum(bytes.NewReader(testCase.content), testCase.checksumAlgorithm, testCase.partSize)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("ObjectChecksum() err %t, want %t", got, want)
+			}
+
+			if got, want := got, testCase.want; got != want {
+				t.Errorf("ObjectChecksum() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func sha256Sum(b []byte) []byte {
+	h := sha256.Sum256(b)
+	return h[:]
+}
+
 func TestAccS3Object_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -1473,6 +1563,82 @@ func TestAccS3Object_checksumAlgorithm(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_multipartUpload(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := testAccObjectCreateTempFile(t, strings.Repeat("A", 2*5*1024*1024+1024))
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_multipartUpload(rName, source, 5*1024*1024),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "ZO9JeqL03u1eYm4L2ykEGUqvIHwbrP1/lCbvcCazbfQ=-3"),
+					resource.TestCheckResourceAttr(resourceName, "upload_concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "upload_part_size", "5242880"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"checksum_algorithm", "checksum_sha256", "force_destroy", "source", "upload_concurrency", "upload_part_size"},
+				ImportStateId:           fmt.Sprintf("s3://%s/test-key", rName),
+			},
+			{
+				Config: testAccObjectConfig_multipartUpload(rName, source, 6*1024*1024),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					// Changing only the part size does not upload the object again.
+					resource.TestCheckResourceAttr(resourceName, "checksum_sha256", "ZO9JeqL03u1eYm4L2ykEGUqvIHwbrP1/lCbvcCazbfQ=-3"),
+					resource.TestCheckResourceAttr(resourceName, "upload_part_size", "6291456"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3Object_checksumDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3EndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "CRC32"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectPutContent(ctx, resourceName, "drift", types.ChecksumAlgorithmCrc32),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectConfig_checksumAlgorithm(rName, "CRC32"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					testAccCheckObjectBody(&obj, "ABCDEFGHIJKLMNOPQRSTUVWXYZ"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32", "q/d4Ig=="),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_keyWithSlashesMigrated(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -1581,6 +1747,26 @@ func testAccCheckObjectExists(ctx context.Context, n string, v *s3.GetObjectOutp
 	}
 }
 
+func testAccCheckObjectPutContent(ctx context.Context, n, content string, checksumAlgorithm types.ChecksumAlgorithm) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := conn.PutObject(ctx, &s3.PutObjectInput{
+			Body:              strings.NewReader(content),
+			Bucket:            aws.String(rs.Primary.Attributes["bucket"]),
+			ChecksumAlgorithm: checksumAlgorithm,
+			Key:               aws.String(tfs3.SDKv1CompatibleCleanKey(rs.Primary.Attributes["key"])),
+		})
+
+		return err
+	}
+}
+
 func testAccCheckObjectBody(obj *s3.GetObjectOutput, want string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		body, err := io.ReadAll(obj.Body)
@@ -2300,6 +2486,24 @@ resource "aws_s3_object" "object" {
 `, rName, checksumAlgorithm)
 }
 
+func testAccObjectConfig_multipartUpload(rName, source string, partSize int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+  source = %[2]q
+
+  checksum_algorithm = "SHA256"
+  upload_concurrency = 2
+  upload_part_size   = %[3]d
+}
+`, rName, source, partSize)
+}
+
 func testAccObjectConfig_keyWithSlashes(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
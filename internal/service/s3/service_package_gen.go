@@ -155,6 +155,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Object",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  ResourceObjectSync,
+			TypeName: "aws_s3_object_sync",
+			Name:     "Object Sync",
+		},
 	}
 }
 